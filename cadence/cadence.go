@@ -0,0 +1,308 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cadence computes release cadence statistics from the release
+// history of projects tracked on NewReleases, per project and aggregated per
+// tag, and predicts when the next release is expected.
+package cadence // import "newreleases.io/newreleases/cadence"
+
+import (
+	"sort"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/version"
+)
+
+// Kind is a kind of a release by the version segment that was changed from
+// the preceding release in version order.
+type Kind int
+
+// Release kinds. KindAll includes releases of all kinds, the release with the
+// lowest version and releases with versions that can not be parsed.
+const (
+	KindAll Kind = iota
+	KindMajor
+	KindMinor
+	KindPatch
+	kindCount
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindMajor:
+		return "major"
+	case KindMinor:
+		return "minor"
+	case KindPatch:
+		return "patch"
+	}
+	return "all"
+}
+
+// Intervals holds statistics of durations between consecutive releases.
+type Intervals struct {
+	// Count is the number of intervals, which is one less than the number of
+	// releases.
+	Count  int
+	Median time.Duration
+	P90    time.Duration
+}
+
+// Month holds the number of releases published in a calendar month.
+type Month struct {
+	// Month is the first day of the month in UTC.
+	Month time.Time
+	Count int
+}
+
+// Stats holds release cadence statistics.
+type Stats struct {
+	// Releases is the number of releases, including prereleases.
+	Releases    int
+	Prereleases int
+	// First and Last are dates of the first and the last release, including
+	// prereleases, and LastStable is the date of the last release that is
+	// not a prerelease.
+	First      time.Time
+	Last       time.Time
+	LastStable time.Time
+	// PerMonth holds the number of releases for every month from the first
+	// to the last release, including months without releases.
+	PerMonth []Month
+	// Intervals holds statistics of intervals between consecutive releases
+	// that are not prereleases, indexed by the release kind.
+	Intervals [kindCount]Intervals
+	// NextRelease is the predicted date of the next release that is not a
+	// prerelease, zero if there are not enough releases for the prediction.
+	NextRelease time.Time
+
+	intervals [kindCount][]time.Duration
+}
+
+// PrereleaseRatio returns the fraction of releases that are prereleases.
+func (s *Stats) PrereleaseRatio() float64 {
+	if s.Releases == 0 {
+		return 0
+	}
+	return float64(s.Prereleases) / float64(s.Releases)
+}
+
+// MonthlyAverage returns the average number of releases per month, from the
+// month of the first to the month of the last release.
+func (s *Stats) MonthlyAverage() float64 {
+	if len(s.PerMonth) == 0 {
+		return 0
+	}
+	return float64(s.Releases) / float64(len(s.PerMonth))
+}
+
+// Overdue returns the time since the last release that is not a prerelease
+// divided by the median interval between such releases. Values greater than
+// one mean that the next release is later than usual. It returns zero if
+// there are not enough releases.
+func (s *Stats) Overdue(now time.Time) float64 {
+	median := s.Intervals[KindAll].Median
+	if median <= 0 || s.LastStable.IsZero() {
+		return 0
+	}
+	return float64(now.Sub(s.LastStable)) / float64(median)
+}
+
+// Compute returns statistics of releases in any order. Releases marked as
+// updated are re-releases of already published versions and are skipped.
+// Releases marked as prereleases and versions with a prerelease part are
+// counted as prereleases. The kind of every other release is determined by
+// comparing its version with the preceding version. The next release is
+// predicted as the median interval after the last release.
+func Compute(releases []newreleases.Release) (s Stats) {
+	sorted := make([]newreleases.Release, 0, len(releases))
+	for _, r := range releases {
+		if !r.IsUpdated {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+
+	releaseKinds := classify(sorted)
+	months := make(map[time.Time]int)
+	var last [kindCount]time.Time
+	for i, r := range sorted {
+		s.Releases++
+		months[month(r.Date)]++
+		if s.First.IsZero() {
+			s.First = r.Date
+		}
+		s.Last = r.Date
+
+		if isPrerelease(r) {
+			s.Prereleases++
+			continue
+		}
+		s.LastStable = r.Date
+		kinds := []Kind{KindAll}
+		if k := releaseKinds[i]; k != KindAll {
+			kinds = append(kinds, k)
+		}
+		for _, k := range kinds {
+			if !last[k].IsZero() {
+				s.intervals[k] = append(s.intervals[k], r.Date.Sub(last[k]))
+			}
+			last[k] = r.Date
+		}
+	}
+	s.PerMonth = perMonth(months)
+	s.summarize()
+	if median := s.Intervals[KindAll].Median; median > 0 {
+		s.NextRelease = s.LastStable.Add(median)
+	}
+	return s
+}
+
+// Aggregate returns statistics of multiple projects, such as projects with
+// the same tag. Release counts are summed, and interval statistics are
+// computed from intervals of all projects, so they describe a typical
+// cadence of a project. The next release is the earliest predicted release
+// of all projects.
+func Aggregate(stats ...Stats) (s Stats) {
+	months := make(map[time.Time]int)
+	for _, p := range stats {
+		s.Releases += p.Releases
+		s.Prereleases += p.Prereleases
+		if !p.First.IsZero() && (s.First.IsZero() || p.First.Before(s.First)) {
+			s.First = p.First
+		}
+		if p.Last.After(s.Last) {
+			s.Last = p.Last
+		}
+		if p.LastStable.After(s.LastStable) {
+			s.LastStable = p.LastStable
+		}
+		for _, m := range p.PerMonth {
+			months[m.Month] += m.Count
+		}
+		for k := range p.intervals {
+			s.intervals[k] = append(s.intervals[k], p.intervals[k]...)
+		}
+		if !p.NextRelease.IsZero() && (s.NextRelease.IsZero() || p.NextRelease.Before(s.NextRelease)) {
+			s.NextRelease = p.NextRelease
+		}
+	}
+	s.PerMonth = perMonth(months)
+	s.summarize()
+	return s
+}
+
+func (s *Stats) summarize() {
+	for k, d := range s.intervals {
+		sorted := append([]time.Duration(nil), d...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		s.Intervals[k] = Intervals{
+			Count:  len(sorted),
+			Median: median(sorted),
+			P90:    percentile(sorted, 90),
+		}
+	}
+}
+
+// isPrerelease returns true if the release is marked as a prerelease or its
+// version has a prerelease part.
+func isPrerelease(r newreleases.Release) bool {
+	if r.IsPrerelease {
+		return true
+	}
+	v, err := r.ParsedVersion()
+	return err == nil && v.IsPrerelease()
+}
+
+// classify returns kinds of releases by comparing the version of every
+// release that is not a prerelease with the preceding version in version
+// order, so that 3.0.1 after 2.5.0 is a major release and 1.2.0.1 after 1.2.0
+// is a patch release. Prereleases, the release with the lowest version and
+// releases with versions that can not be parsed have KindAll.
+func classify(releases []newreleases.Release) (kinds []Kind) {
+	type parsed struct {
+		i int
+		v version.Version
+	}
+	var stable []parsed
+	for i, r := range releases {
+		v, err := r.ParsedVersion()
+		if err != nil || r.IsPrerelease || v.IsPrerelease() {
+			continue
+		}
+		stable = append(stable, parsed{i: i, v: v})
+	}
+	sort.SliceStable(stable, func(i, j int) bool {
+		return stable[i].v.LessThan(stable[j].v)
+	})
+
+	kinds = make([]Kind, len(releases))
+	for j := 1; j < len(stable); j++ {
+		prev, v := stable[j-1].v, stable[j].v
+		switch {
+		case v.Major() != prev.Major():
+			kinds[stable[j].i] = KindMajor
+		case v.Minor() != prev.Minor():
+			kinds[stable[j].i] = KindMinor
+		case !v.Equal(prev):
+			kinds[stable[j].i] = KindPatch
+		}
+	}
+	return kinds
+}
+
+func month(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// perMonth returns release counts for all months from the earliest to the
+// latest month.
+func perMonth(counts map[time.Time]int) (months []Month) {
+	if len(counts) == 0 {
+		return nil
+	}
+	var first, last time.Time
+	for m := range counts {
+		if first.IsZero() || m.Before(first) {
+			first = m
+		}
+		if m.After(last) {
+			last = m
+		}
+	}
+	for m := first; !m.After(last); m = m.AddDate(0, 1, 0) {
+		months = append(months, Month{Month: m, Count: counts[m]})
+	}
+	return months
+}
+
+// median returns the median of sorted durations.
+func median(d []time.Duration) time.Duration {
+	n := len(d)
+	if n == 0 {
+		return 0
+	}
+	if n%2 == 1 {
+		return d[n/2]
+	}
+	return (d[n/2-1] + d[n/2]) / 2
+}
+
+// percentile returns the nearest-rank percentile of sorted durations.
+func percentile(d []time.Duration, p int) time.Duration {
+	n := len(d)
+	if n == 0 {
+		return 0
+	}
+	rank := (p*n + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return d[rank-1]
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cadence_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/cadence"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+const day = 24 * time.Hour
+
+func TestCompute(t *testing.T) {
+	s := cadence.Compute([]newreleases.Release{
+		{Version: "nightly", Date: testutil.Date("2023-04-11")},
+		{Version: "v2.0.0", Date: testutil.Date("2023-04-01")},
+		{Version: "v1.1.1", Date: testutil.Date("2023-02-07"), IsUpdated: true},
+		{Version: "v1.1.1", Date: testutil.Date("2023-02-06")},
+		{Version: "v1.1.0", Date: testutil.Date("2023-02-01")},
+		{Version: "v1.1.0-rc.1", Date: testutil.Date("2023-01-20")},
+		{Version: "v1.0.1", Date: testutil.Date("2023-01-11")},
+		{Version: "v1.0.0", Date: testutil.Date("2023-01-01")},
+	})
+
+	testutil.AssertEqual(t, "releases", s.Releases, 7)
+	testutil.AssertEqual(t, "prereleases", s.Prereleases, 1)
+	testutil.AssertEqual(t, "prerelease ratio", s.PrereleaseRatio(), 1.0/7)
+	testutil.AssertEqual(t, "first", s.First, testutil.Date("2023-01-01"))
+	testutil.AssertEqual(t, "last", s.Last, testutil.Date("2023-04-11"))
+	testutil.AssertEqual(t, "last stable", s.LastStable, testutil.Date("2023-04-11"))
+	testutil.AssertEqual(t, "per month", s.PerMonth, []cadence.Month{
+		{Month: testutil.Date("2023-01-01"), Count: 3},
+		{Month: testutil.Date("2023-02-01"), Count: 2},
+		{Month: testutil.Date("2023-03-01"), Count: 0},
+		{Month: testutil.Date("2023-04-01"), Count: 2},
+	})
+	testutil.AssertEqual(t, "monthly average", s.MonthlyAverage(), 7.0/4)
+	testutil.AssertEqual(t, "all intervals", s.Intervals[cadence.KindAll], cadence.Intervals{Count: 5, Median: 10 * day, P90: 54 * day})
+	testutil.AssertEqual(t, "major intervals", s.Intervals[cadence.KindMajor], cadence.Intervals{})
+	testutil.AssertEqual(t, "minor intervals", s.Intervals[cadence.KindMinor], cadence.Intervals{})
+	testutil.AssertEqual(t, "patch intervals", s.Intervals[cadence.KindPatch], cadence.Intervals{Count: 1, Median: 26 * day, P90: 26 * day})
+	testutil.AssertEqual(t, "next release", s.NextRelease, testutil.Date("2023-04-21"))
+	testutil.AssertEqual(t, "overdue", s.Overdue(testutil.Date("2023-05-11")), 3.0)
+}
+
+func TestCompute_prerelease(t *testing.T) {
+	s := cadence.Compute([]newreleases.Release{
+		{Version: "v2.0.0-beta.1", Date: testutil.Date("2023-03-01")},
+		{Version: "v1.1.0", Date: testutil.Date("2023-02-01"), IsPrerelease: true},
+		{Version: "v1.0.0", Date: testutil.Date("2023-01-01")},
+	})
+
+	testutil.AssertEqual(t, "prereleases", s.Prereleases, 2)
+	testutil.AssertEqual(t, "last", s.Last, testutil.Date("2023-03-01"))
+	testutil.AssertEqual(t, "last stable", s.LastStable, testutil.Date("2023-01-01"))
+	testutil.AssertEqual(t, "all intervals", s.Intervals[cadence.KindAll], cadence.Intervals{})
+	testutil.AssertEqual(t, "next release", s.NextRelease, time.Time{})
+	testutil.AssertEqual(t, "overdue", s.Overdue(testutil.Date("2023-05-01")), 0.0)
+}
+
+func TestCompute_kinds(t *testing.T) {
+	s := cadence.Compute([]newreleases.Release{
+		{Version: "2.5.1", Date: testutil.Date("2023-01-26")},
+		{Version: "3.0.2", Date: testutil.Date("2023-01-22")},
+		{Version: "3.0.1", Date: testutil.Date("2023-01-21")},
+		{Version: "2.5.0", Date: testutil.Date("2023-01-11")},
+		{Version: "1.2.0.1", Date: testutil.Date("2023-01-03")},
+		{Version: "1.2.0", Date: testutil.Date("2023-01-01")},
+	})
+
+	testutil.AssertEqual(t, "major intervals", s.Intervals[cadence.KindMajor], cadence.Intervals{Count: 1, Median: 10 * day, P90: 10 * day})
+	testutil.AssertEqual(t, "minor intervals", s.Intervals[cadence.KindMinor], cadence.Intervals{})
+	testutil.AssertEqual(t, "patch intervals", s.Intervals[cadence.KindPatch], cadence.Intervals{Count: 2, Median: 11*day + 12*time.Hour, P90: 19 * day})
+
+	s = cadence.Compute([]newreleases.Release{
+		{Version: "2024.11.0", Date: testutil.Date("2024-03-01")},
+		{Version: "2024.10.0", Date: testutil.Date("2024-02-01")},
+		{Version: "2024.9.0", Date: testutil.Date("2024-01-01")},
+	})
+
+	testutil.AssertEqual(t, "calendar major intervals", s.Intervals[cadence.KindMajor], cadence.Intervals{})
+	testutil.AssertEqual(t, "calendar minor intervals", s.Intervals[cadence.KindMinor], cadence.Intervals{Count: 1, Median: 29 * day, P90: 29 * day})
+}
+
+func TestCompute_empty(t *testing.T) {
+	s := cadence.Compute(nil)
+
+	testutil.AssertEqual(t, "releases", s.Releases, 0)
+	testutil.AssertEqual(t, "per month", s.PerMonth, []cadence.Month(nil))
+	testutil.AssertEqual(t, "prerelease ratio", s.PrereleaseRatio(), 0.0)
+	testutil.AssertEqual(t, "monthly average", s.MonthlyAverage(), 0.0)
+}
+
+func TestCompute_intervals(t *testing.T) {
+	var releases []newreleases.Release
+	d := testutil.Date("2023-01-01")
+	// Intervals of 1 to 10 days between patch releases.
+	for i := 0; i <= 10; i++ {
+		d = d.Add(time.Duration(i) * day)
+		releases = append(releases, newreleases.Release{Version: "v1.0." + strconv.Itoa(i), Date: d})
+	}
+	s := cadence.Compute(releases)
+
+	testutil.AssertEqual(t, "all intervals", s.Intervals[cadence.KindAll], cadence.Intervals{Count: 10, Median: 5*day + 12*time.Hour, P90: 9 * day})
+	testutil.AssertEqual(t, "patch intervals", s.Intervals[cadence.KindPatch], cadence.Intervals{Count: 9, Median: 6 * day, P90: 10 * day})
+}
+
+func TestAggregate(t *testing.T) {
+	a := cadence.Compute([]newreleases.Release{
+		{Version: "v1.0.0", Date: testutil.Date("2023-01-01")},
+		{Version: "v1.0.1", Date: testutil.Date("2023-01-05")},
+		{Version: "v1.0.2", Date: testutil.Date("2023-01-07")},
+	})
+	b := cadence.Compute([]newreleases.Release{
+		{Version: "2.0.0-rc.1", Date: testutil.Date("2023-02-20")},
+		{Version: "2.0.0", Date: testutil.Date("2023-03-01")},
+		{Version: "2.1.0", Date: testutil.Date("2023-03-31")},
+	})
+	s := cadence.Aggregate(a, b, cadence.Compute(nil))
+
+	testutil.AssertEqual(t, "releases", s.Releases, 6)
+	testutil.AssertEqual(t, "prereleases", s.Prereleases, 1)
+	testutil.AssertEqual(t, "first", s.First, testutil.Date("2023-01-01"))
+	testutil.AssertEqual(t, "last", s.Last, testutil.Date("2023-03-31"))
+	testutil.AssertEqual(t, "last stable", s.LastStable, testutil.Date("2023-03-31"))
+	testutil.AssertEqual(t, "per month", s.PerMonth, []cadence.Month{
+		{Month: testutil.Date("2023-01-01"), Count: 3},
+		{Month: testutil.Date("2023-02-01"), Count: 1},
+		{Month: testutil.Date("2023-03-01"), Count: 2},
+	})
+	testutil.AssertEqual(t, "all intervals", s.Intervals[cadence.KindAll], cadence.Intervals{Count: 3, Median: 4 * day, P90: 30 * day})
+	testutil.AssertEqual(t, "patch intervals", s.Intervals[cadence.KindPatch], cadence.Intervals{Count: 1, Median: 2 * day, P90: 2 * day})
+	testutil.AssertEqual(t, "next release", s.NextRelease, testutil.Date("2023-01-10"))
+}
+
+func TestKind_String(t *testing.T) {
+	for k, want := range map[cadence.Kind]string{
+		cadence.KindAll:   "all",
+		cadence.KindMajor: "major",
+		cadence.KindMinor: "minor",
+		cadence.KindPatch: "patch",
+	} {
+		testutil.AssertEqual(t, "", k.String(), want)
+	}
+}
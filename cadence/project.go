@@ -0,0 +1,108 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cadence
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"newreleases.io/newreleases"
+)
+
+// ProjectStats holds release cadence statistics of a project.
+type ProjectStats struct {
+	Project newreleases.Project
+	Stats
+}
+
+// TagStats holds release cadence statistics of projects with a tag, and
+// statistics aggregated over all of them.
+type TagStats struct {
+	Tag newreleases.Tag
+	Stats
+	// Projects are ordered by the predicted next release, with projects
+	// without a prediction last.
+	Projects []ProjectStats
+}
+
+// Options holds optional parameters for ForProject and ForTag.
+type Options struct {
+	// Since limits statistics to releases published on or after Since, if it
+	// is not zero.
+	Since time.Time
+	// MaxPages limits the number of listed release pages for every project.
+	// All pages are listed if it is zero.
+	MaxPages int
+}
+
+// ForProject lists releases of a project referenced by its ID or by its
+// provider and name, and returns its release cadence statistics.
+func ForProject(ctx context.Context, client *newreleases.Client, ref newreleases.ProjectRef, o *Options) (s *ProjectStats, err error) {
+	if o == nil {
+		o = new(Options)
+	}
+	p, err := client.Projects.Get(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return projectStats(ctx, client, *p, o)
+}
+
+// ForTag lists releases of all projects with the tag and returns their
+// release cadence statistics.
+func ForTag(ctx context.Context, client *newreleases.Client, tagID string, o *Options) (s *TagStats, err error) {
+	if o == nil {
+		o = new(Options)
+	}
+	tag, err := client.Tags.Get(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+	s = &TagStats{Tag: *tag}
+	err = client.Projects.Walk(ctx, newreleases.ProjectListOptions{
+		TagID: tagID,
+	}, func(p newreleases.Project) (stop bool, err error) {
+		ps, err := projectStats(ctx, client, p, o)
+		if err != nil {
+			return false, err
+		}
+		s.Projects = append(s.Projects, *ps)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]Stats, len(s.Projects))
+	for i, p := range s.Projects {
+		stats[i] = p.Stats
+	}
+	s.Stats = Aggregate(stats...)
+	sort.SliceStable(s.Projects, func(i, j int) bool {
+		a, b := s.Projects[i].NextRelease, s.Projects[j].NextRelease
+		if a.IsZero() || b.IsZero() {
+			return !a.IsZero() && b.IsZero()
+		}
+		return a.Before(b)
+	})
+	return s, nil
+}
+
+func projectStats(ctx context.Context, client *newreleases.Client, p newreleases.Project, o *Options) (s *ProjectStats, err error) {
+	var releases []newreleases.Release
+	err = client.Releases.WalkSince(ctx, p.Ref(), o.Since, o.MaxPages, func(r newreleases.Release) (stop bool, err error) {
+		releases = append(releases, r)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectStats{
+		Project: p,
+		Stats:   Compute(releases),
+	}, nil
+}
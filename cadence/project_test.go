@@ -0,0 +1,105 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cadence_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/cadence"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestForProject(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/projects/github/acme/app", `{"id": "app", "provider": "github", "name": "acme/app"}`)
+	mux.HandleFunc("/v1/projects/app/releases", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprintln(w, `{"releases": [
+				{"version": "v1.2.0", "date": "2023-03-01T00:00:00Z"},
+				{"version": "v1.1.0", "date": "2023-02-01T00:00:00Z"}
+			], "total_pages": 4}`)
+		case "2":
+			fmt.Fprintln(w, `{"releases": [
+				{"version": "v1.0.0", "date": "2023-01-01T00:00:00Z"},
+				{"version": "v0.9.0", "date": "2022-06-01T00:00:00Z"}
+			], "total_pages": 4}`)
+		case "3":
+			// All releases are before Since, so the last page is not listed.
+			fmt.Fprintln(w, `{"releases": [
+				{"version": "v0.8.0", "date": "2022-01-01T00:00:00Z"}
+			], "total_pages": 4}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	got, err := cadence.ForProject(context.Background(), client, newreleases.ProjectRefByName(newreleases.ProviderGitHub, "acme/app"), &cadence.Options{
+		Since: testutil.Date("2023-01-01"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "project", got.Project, newreleases.Project{ID: "app", Provider: "github", Name: "acme/app"})
+	testutil.AssertEqual(t, "releases", got.Releases, 3)
+	testutil.AssertEqual(t, "first", got.First, testutil.Date("2023-01-01"))
+	testutil.AssertEqual(t, "minor intervals", got.Intervals[cadence.KindMinor], cadence.Intervals{Count: 1, Median: 28 * day, P90: 28 * day})
+	testutil.AssertEqual(t, "next release", got.NextRelease, testutil.Date("2023-03-30").Add(12*time.Hour))
+}
+
+func TestForTag(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/tags/t1", `{"id": "t1", "name": "backend"}`)
+	mux.HandleFunc("/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag"); got != "t1" {
+			t.Errorf("got tag %q, want %q", got, "t1")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintln(w, `{"projects": [
+			{"id": "quiet", "provider": "npm", "name": "quiet"},
+			{"id": "slow", "provider": "npm", "name": "slow"},
+			{"id": "fast", "provider": "npm", "name": "fast"}
+		], "total_pages": 1}`)
+	})
+	testutil.Handle(mux, "/v1/projects/quiet/releases", `{"releases": [
+		{"version": "1.0.0", "date": "2023-01-01T00:00:00Z"}
+	], "total_pages": 1}`)
+	testutil.Handle(mux, "/v1/projects/slow/releases", `{"releases": [
+		{"version": "2.0.0", "date": "2023-03-01T00:00:00Z"},
+		{"version": "1.0.0", "date": "2023-01-01T00:00:00Z"}
+	], "total_pages": 1}`)
+	testutil.Handle(mux, "/v1/projects/fast/releases", `{"releases": [
+		{"version": "1.0.2", "date": "2023-01-21T00:00:00Z"},
+		{"version": "1.0.1", "date": "2023-01-11T00:00:00Z"},
+		{"version": "1.0.0", "date": "2023-01-01T00:00:00Z"}
+	], "total_pages": 1}`)
+
+	got, err := cadence.ForTag(context.Background(), client, "t1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "tag", got.Tag, newreleases.Tag{ID: "t1", Name: "backend"})
+	var names []string
+	for _, p := range got.Projects {
+		names = append(names, p.Project.Name)
+	}
+	testutil.AssertEqual(t, "projects", names, []string{"fast", "slow", "quiet"})
+	testutil.AssertEqual(t, "releases", got.Releases, 6)
+	testutil.AssertEqual(t, "all intervals", got.Intervals[cadence.KindAll], cadence.Intervals{Count: 3, Median: 10 * day, P90: 59 * day})
+	testutil.AssertEqual(t, "next release", got.NextRelease, testutil.Date("2023-01-31"))
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package changelog assembles release notes of project releases between two
+// versions into a single Markdown or HTML document.
+package changelog // import "newreleases.io/newreleases/changelog"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/version"
+)
+
+// DefaultConcurrency is the number of release notes that are fetched at the
+// same time, if it is not set in Options.
+const DefaultConcurrency = 4
+
+// Changelog holds releases of a project between two versions with their
+// notes.
+type Changelog struct {
+	Project newreleases.Project
+	// From is the version after which releases are included, and To is the
+	// last included version.
+	From string
+	To   string
+	// Entries are ordered by version, from the lowest to the highest.
+	Entries []Entry
+}
+
+// Entry holds a release and its note.
+type Entry struct {
+	newreleases.Release
+	// Note is nil if the release has no note.
+	Note *newreleases.ReleaseNote
+}
+
+// Options holds optional parameters for Generate.
+type Options struct {
+	// Prereleases includes releases marked as prereleases and versions with a
+	// prerelease part.
+	Prereleases bool
+	// Concurrency is the number of release notes that are fetched at the same
+	// time. DefaultConcurrency is used if it is zero.
+	Concurrency int
+	// MaxPages limits the number of listed release pages. All pages are listed
+	// if it is zero.
+	MaxPages int
+}
+
+// Generate returns the changelog of releases with versions greater than the
+// from version and lower than or equal to the to version, for a project
+// referenced by its ID or by its provider and name. Any of the versions can be
+// empty to not limit the range on that side. Notes are fetched concurrently,
+// only for releases that have them.
+func Generate(ctx context.Context, client *newreleases.Client, ref newreleases.ProjectRef, from, to string, o *Options) (c *Changelog, err error) {
+	if o == nil {
+		o = new(Options)
+	}
+	lower, err := parseBound(from)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := parseBound(to)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := client.Projects.Get(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	releases, err := client.Releases.ListAll(ctx, project.Ref(), o.MaxPages)
+	if err != nil {
+		return nil, err
+	}
+
+	type entry struct {
+		Entry
+		version version.Version
+	}
+	var entries []entry
+	for _, r := range releases {
+		if r.IsPrerelease && !o.Prereleases {
+			continue
+		}
+		v, err := r.ParsedVersion()
+		if err != nil || v.IsPrerelease() && !o.Prereleases {
+			continue
+		}
+		if lower != nil && !v.GreaterThan(*lower) || upper != nil && v.GreaterThan(*upper) {
+			continue
+		}
+		entries = append(entries, entry{Entry: Entry{Release: r}, version: v})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].version.LessThan(entries[j].version)
+	})
+
+	c = &Changelog{
+		Project: *project,
+		From:    from,
+		To:      to,
+		Entries: make([]Entry, len(entries)),
+	}
+	for i, e := range entries {
+		c.Entries[i] = e.Entry
+	}
+	if err := fetchNotes(ctx, client, project.ID, c.Entries, o.Concurrency); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func parseBound(s string) (v *version.Version, err error) {
+	if s == "" {
+		return nil, nil
+	}
+	parsed, err := version.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// fetchNotes sets notes for entries of releases that have them, fetching at
+// most concurrency notes at the same time. It stops on the first error.
+func fetchNotes(ctx context.Context, client *newreleases.Client, projectID string, entries []Entry, concurrency int) (err error) {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		sem      = make(chan struct{}, concurrency)
+	)
+	for i := range entries {
+		if !entries[i].HasNote {
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(e *Entry) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			note, err := client.Releases.GetNoteByProjectID(ctx, projectID, e.Version)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("release %s note: %w", e.Version, err)
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+			e.Note = note
+		}(&entries[i])
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package changelog_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/changelog"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestGenerate(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/projects/github/acme/app", `{"id": "app", "provider": "github", "name": "acme/app"}`)
+	testutil.Handle(mux, "/v1/projects/app/releases", `{"releases": [
+		{"version": "v1.10.0", "date": "2023-06-01T00:00:00Z", "has_note": true},
+		{"version": "v1.9.2", "date": "2023-05-01T00:00:00Z", "has_note": true, "cve": ["CVE-2023-0001"]},
+		{"version": "v1.9.0-rc.1", "date": "2023-04-01T00:00:00Z", "is_prerelease": true, "has_note": true},
+		{"version": "v1.8.1", "date": "2023-03-15T00:00:00Z"},
+		{"version": "v1.4.1", "date": "2023-03-01T00:00:00Z", "has_note": true},
+		{"version": "v1.5.0", "date": "2023-02-01T00:00:00Z", "has_note": true},
+		{"version": "v1.4.0", "date": "2023-01-01T00:00:00Z", "has_note": true}
+	], "total_pages": 1}`)
+	var noteRequests int32
+	mux.HandleFunc("/v1/projects/app/releases/", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&noteRequests, 1)
+		var v string
+		fmt.Sscanf(r.URL.Path, "/v1/projects/app/releases/%s", &v)
+		v = v[:len(v)-len("/note")]
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintf(w, `{"title": "Release %s", "message": "Changes in %s.", "url": "https://github.com/acme/app/releases/tag/%s"}`, v, v, v)
+	})
+
+	got, err := changelog.Generate(context.Background(), client, newreleases.ProjectRefByName(newreleases.ProviderGitHub, "acme/app"), "v1.4.0", "v1.9.2", &changelog.Options{Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	note := func(v string) *newreleases.ReleaseNote {
+		return &newreleases.ReleaseNote{
+			Title:   "Release " + v,
+			Message: "Changes in " + v + ".",
+			URL:     "https://github.com/acme/app/releases/tag/" + v,
+		}
+	}
+	testutil.AssertEqual(t, "project", got.Project, newreleases.Project{ID: "app", Provider: "github", Name: "acme/app"})
+	testutil.AssertEqual(t, "entries", got.Entries, []changelog.Entry{
+		{Release: newreleases.Release{Version: "v1.4.1", Date: testutil.Date("2023-03-01"), HasNote: true}, Note: note("v1.4.1")},
+		{Release: newreleases.Release{Version: "v1.5.0", Date: testutil.Date("2023-02-01"), HasNote: true}, Note: note("v1.5.0")},
+		{Release: newreleases.Release{Version: "v1.8.1", Date: testutil.Date("2023-03-15")}},
+		{Release: newreleases.Release{Version: "v1.9.2", Date: testutil.Date("2023-05-01"), HasNote: true, CVE: []string{"CVE-2023-0001"}}, Note: note("v1.9.2")},
+	})
+	testutil.AssertEqual(t, "note requests", atomic.LoadInt32(&noteRequests), int32(3))
+}
+
+func TestGenerate_noteError(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/projects/app", `{"id": "app", "provider": "github", "name": "acme/app"}`)
+	testutil.Handle(mux, "/v1/projects/app/releases", `{"releases": [
+		{"version": "v1.1.0", "date": "2023-02-01T00:00:00Z", "has_note": true}
+	], "total_pages": 1}`)
+	mux.HandleFunc("/v1/projects/app/releases/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := changelog.Generate(context.Background(), client, newreleases.ProjectRefByID("app"), "", "", nil)
+	if !errors.Is(err, newreleases.ErrNotFound) {
+		t.Fatalf("got error %v, want %v", err, newreleases.ErrNotFound)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package changelog
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/render"
+)
+
+// Title returns the changelog title with the project and the version range.
+func (c *Changelog) Title() string {
+	title := "Changelog for " + string(c.Project.Provider) + "/" + c.Project.Name
+	switch {
+	case c.From != "" && c.To != "":
+		title += " from " + c.From + " to " + c.To
+	case c.From != "":
+		title += " after " + c.From
+	case c.To != "":
+		title += " up to " + c.To
+	}
+	return title
+}
+
+// WriteMarkdown writes the changelog as a Markdown document with a section
+// for every release. Note messages are converted to Markdown.
+func (c *Changelog) WriteMarkdown(w io.Writer) (err error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", render.EscapeMarkdown(strings.Join(strings.Fields(c.Title()), " ")))
+	if len(c.Entries) == 0 {
+		b.WriteString("\nNo releases.\n")
+	}
+	for _, e := range c.Entries {
+		heading := render.EscapeMarkdown(e.Version)
+		if u := noteURL(e.Note); u != "" {
+			heading = "[" + heading + "](" + render.EscapeMarkdownURL(u) + ")"
+		}
+		fmt.Fprintf(&b, "\n## %s (%s)\n", heading, e.Date.Format(render.DateFormat))
+		if e.IsPrerelease {
+			b.WriteString("\nPrerelease.\n")
+		}
+		if len(e.CVE) > 0 {
+			links := make([]string, len(e.CVE))
+			for i, id := range e.CVE {
+				links[i] = "[" + render.EscapeMarkdown(id) + "](" + newreleases.CVEURL(id) + ")"
+			}
+			fmt.Fprintf(&b, "\nSecurity fixes: %s\n", strings.Join(links, ", "))
+		}
+		if e.Note == nil {
+			continue
+		}
+		if e.Note.Title != "" {
+			// Titles are written on a single line so that they can not end
+			// the heading or start another block.
+			title := strings.Join(strings.Fields(e.Note.Title), " ")
+			fmt.Fprintf(&b, "\n### %s\n", render.EscapeMarkdown(title))
+		}
+		if m := render.Parse(e.Note).Markdown(); m != "" {
+			fmt.Fprintf(&b, "\n%s\n", m)
+		}
+	}
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// noteURL returns the URL of the release note if it is an http or https URL
+// that is safe to link to, and an empty string otherwise.
+func noteURL(note *newreleases.ReleaseNote) string {
+	if note == nil {
+		return ""
+	}
+	u, err := url.Parse(strings.TrimSpace(note.URL))
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return u.String()
+	}
+	return ""
+}
+
+var htmlTemplate = template.Must(template.New("").Funcs(template.FuncMap{
+	"cveURL":  newreleases.CVEURL,
+	"noteURL": noteURL,
+	"date": func(t time.Time) string {
+		return t.Format(render.DateFormat)
+	},
+	"noteHTML": func(note *newreleases.ReleaseNote) template.HTML {
+		// Notes are sanitized by the render package.
+		return template.HTML(render.Parse(note).HTML())
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{- range .Entries}}
+<section>
+{{- $url := noteURL .Note}}
+<h2>{{if $url}}<a href="{{$url}}">{{.Version}}</a>{{else}}{{.Version}}{{end}} <time datetime="{{date .Date}}">{{date .Date}}</time></h2>
+{{- if .IsPrerelease}}
+<p>Prerelease.</p>
+{{- end}}
+{{- if .CVE}}
+<p>Security fixes:{{range $i, $id := .CVE}}{{if $i}},{{end}} <a href="{{cveURL $id}}">{{$id}}</a>{{end}}</p>
+{{- end}}
+{{- with .Note}}
+{{- if .Title}}
+<h3>{{.Title}}</h3>
+{{- end}}
+{{- with noteHTML .}}
+<div>
+{{.}}
+</div>
+{{- end}}
+{{- end}}
+</section>
+{{- else}}
+<p>No releases.</p>
+{{- end}}
+</body>
+</html>
+`))
+
+// WriteHTML writes the changelog as an HTML document with a section for
+// every release. Note messages are converted to sanitized HTML.
+func (c *Changelog) WriteHTML(w io.Writer) (err error) {
+	return htmlTemplate.Execute(w, c)
+}
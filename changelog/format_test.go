@@ -0,0 +1,150 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package changelog_test
+
+import (
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/changelog"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+var formatChangelog = &changelog.Changelog{
+	Project: newreleases.Project{Provider: "github", Name: "acme/app"},
+	From:    "v1.4.0",
+	To:      "v1.9.2",
+	Entries: []changelog.Entry{
+		{
+			Release: newreleases.Release{Version: "v1.5.0-rc.1", Date: testutil.Date("2023-02-01"), IsPrerelease: true},
+		},
+		{
+			Release: newreleases.Release{Version: "v1.9.2", Date: testutil.Date("2023-05-01"), HasNote: true, CVE: []string{"CVE-2023-0001", "CVE-2023-0002"}},
+			Note: &newreleases.ReleaseNote{
+				Title:   "Security <release>",
+				Message: "<p>Fixes <b>crash</b> in <a href=\"/acme/app/pull/2\">#2</a>.<script>alert(1)</script></p>",
+				URL:     "https://github.com/acme/app/releases/tag/v1.9.2",
+			},
+		},
+	},
+}
+
+func TestChangelog_WriteMarkdown(t *testing.T) {
+	var b strings.Builder
+	if err := formatChangelog.WriteMarkdown(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", b.String(), `# Changelog for github/acme/app from v1.4.0 to v1.9.2
+
+## v1.5.0-rc.1 (2023-02-01)
+
+Prerelease.
+
+## [v1.9.2](https://github.com/acme/app/releases/tag/v1.9.2) (2023-05-01)
+
+Security fixes: [CVE-2023-0001](https://nvd.nist.gov/vuln/detail/CVE-2023-0001), [CVE-2023-0002](https://nvd.nist.gov/vuln/detail/CVE-2023-0002)
+
+### Security \<release>
+
+Fixes **crash** in [#2](https://github.com/acme/app/pull/2).
+`)
+}
+
+func TestChangelog_WriteMarkdown_escape(t *testing.T) {
+	c := &changelog.Changelog{
+		Project: newreleases.Project{Provider: "github", Name: "acme/app"},
+		Entries: []changelog.Entry{
+			{
+				Release: newreleases.Release{Version: "v1.0.0_*beta*", Date: testutil.Date("2023-05-01")},
+				Note: &newreleases.ReleaseNote{
+					Title: "[click](https://example.com)\n# Injected",
+				},
+			},
+		},
+	}
+
+	var b strings.Builder
+	if err := c.WriteMarkdown(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", b.String(), `# Changelog for github/acme/app
+
+## v1.0.0\_\*beta\* (2023-05-01)
+
+### \[click\](https://example.com) # Injected
+`)
+}
+
+func TestChangelog_WriteMarkdown_links(t *testing.T) {
+	c := &changelog.Changelog{
+		Project: newreleases.Project{Provider: "github", Name: "acme/[app](https://example.com)"},
+		Entries: []changelog.Entry{
+			{
+				Release: newreleases.Release{Version: "v1.1.0", Date: testutil.Date("2023-05-02")},
+				Note:    &newreleases.ReleaseNote{URL: "https://example.com/notes (1).html"},
+			},
+			{
+				Release: newreleases.Release{Version: "v1.0.0", Date: testutil.Date("2023-05-01")},
+				Note:    &newreleases.ReleaseNote{URL: "javascript:alert(1)"},
+			},
+		},
+	}
+
+	var b strings.Builder
+	if err := c.WriteMarkdown(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", b.String(), `# Changelog for github/acme/\[app\](https://example.com)
+
+## [v1.1.0](https://example.com/notes%20%281%29.html) (2023-05-02)
+
+## v1.0.0 (2023-05-01)
+`)
+}
+
+func TestChangelog_WriteHTML(t *testing.T) {
+	var b strings.Builder
+	if err := formatChangelog.WriteHTML(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", b.String(), `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Changelog for github/acme/app from v1.4.0 to v1.9.2</title>
+</head>
+<body>
+<h1>Changelog for github/acme/app from v1.4.0 to v1.9.2</h1>
+<section>
+<h2>v1.5.0-rc.1 <time datetime="2023-02-01">2023-02-01</time></h2>
+<p>Prerelease.</p>
+</section>
+<section>
+<h2><a href="https://github.com/acme/app/releases/tag/v1.9.2">v1.9.2</a> <time datetime="2023-05-01">2023-05-01</time></h2>
+<p>Security fixes: <a href="https://nvd.nist.gov/vuln/detail/CVE-2023-0001">CVE-2023-0001</a>, <a href="https://nvd.nist.gov/vuln/detail/CVE-2023-0002">CVE-2023-0002</a></p>
+<h3>Security &lt;release&gt;</h3>
+<div>
+<p>Fixes <b>crash</b> in <a href="https://github.com/acme/app/pull/2">#2</a>.</p>
+</div>
+</section>
+</body>
+</html>
+`)
+}
+
+func TestChangelog_Title(t *testing.T) {
+	c := &changelog.Changelog{Project: newreleases.Project{Provider: "npm", Name: "react"}}
+	testutil.AssertEqual(t, "no range", c.Title(), "Changelog for npm/react")
+	c.From = "18.0.0"
+	testutil.AssertEqual(t, "from", c.Title(), "Changelog for npm/react after 18.0.0")
+	c.From, c.To = "", "18.2.0"
+	testutil.AssertEqual(t, "to", c.Title(), "Changelog for npm/react up to 18.2.0")
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command newreleases-outdated reports Go module requirements that are behind
+// the latest releases of projects tracked on NewReleases.
+//
+// It exits with status 1 if any requirement lags behind the latest release by
+// at least the level set with the -fail-on flag, unless it is none, or if
+// untracked modules are found and the -fail-untracked flag is set, and with
+// status 2 on errors.
+//
+// With the -upgrade flag, it prints go get commands that upgrade requirements
+// up to the given level instead, or a unified diff of the go.mod file with the
+// -diff flag.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/outdated"
+)
+
+const (
+	exitViolation = 1
+	exitError     = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, nil))
+}
+
+// run executes the command with the arguments and returns its exit code. The
+// API client is constructed with the provided options, which may be nil.
+func run(args []string, stdout, stderr io.Writer, opts *newreleases.ClientOptions) (code int) {
+	flags := flag.NewFlagSet("newreleases-outdated", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: newreleases-outdated [flags] [go.mod]")
+		flags.PrintDefaults()
+	}
+	authKey := flags.String("auth-key", os.Getenv("NEWRELEASES_AUTH_KEY"), "NewReleases API auth key, defaults to NEWRELEASES_AUTH_KEY environment variable")
+	failOn := flags.String("fail-on", "major", "lowest lag that fails the check: patch, minor or major, or none to never fail on lags")
+	failUntracked := flags.Bool("fail-untracked", false, "fail the check if some modules are not tracked")
+	indirect := flags.Bool("indirect", false, "check indirect requirements")
+	jsonOutput := flags.Bool("json", false, "print the report in JSON format")
+	upgrade := flags.String("upgrade", "", "print go get commands for upgrades up to the level: patch, minor or major")
+	diff := flags.Bool("diff", false, "print upgrades as a unified diff of the go.mod file, used with -upgrade")
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	filename := "go.mod"
+	switch flags.NArg() {
+	case 0:
+	case 1:
+		filename = flags.Arg(0)
+	default:
+		flags.Usage()
+		return exitError
+	}
+	if *diff && *upgrade == "" {
+		fmt.Fprintln(stderr, "error: -diff requires -upgrade")
+		flags.Usage()
+		return exitError
+	}
+	if *authKey == "" {
+		fmt.Fprintln(stderr, "error: auth key is not set")
+		return exitError
+	}
+	lag, err := outdated.ParseLag(*failOn)
+	if err != nil {
+		fmt.Fprintln(stderr, "error:", err)
+		return exitError
+	}
+
+	client := newreleases.NewClient(*authKey, opts)
+
+	if *upgrade != "" {
+		level, err := outdated.ParseLag(*upgrade)
+		if err == nil && level == outdated.LagNone {
+			err = fmt.Errorf("%w %q: upgrade level must be patch, minor or major", outdated.ErrInvalidLag, *upgrade)
+		}
+		if err != nil {
+			fmt.Fprintln(stderr, "error:", err)
+			return exitError
+		}
+		if err := printUpgrades(stdout, stderr, client, filename, level, *indirect, *diff); err != nil {
+			fmt.Fprintln(stderr, "error:", err)
+			return exitError
+		}
+		return 0
+	}
+
+	report, err := outdated.CheckFile(context.Background(), client, filename, &outdated.Options{
+		Indirect: *indirect,
+	})
+	if err != nil {
+		fmt.Fprintln(stderr, "error:", err)
+		return exitError
+	}
+
+	violations := report.Violations(lag)
+	if *jsonOutput {
+		err = printJSON(stdout, report, violations)
+	} else {
+		err = printText(stdout, report, violations)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, "error:", err)
+		return exitError
+	}
+
+	if len(violations) > 0 || *failUntracked && len(report.Untracked) > 0 {
+		return exitViolation
+	}
+	return 0
+}
+
+func printUpgrades(stdout, stderr io.Writer, client *newreleases.Client, filename string, level outdated.Lag, indirect, diff bool) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	m, err := outdated.ParseGoMod(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
+	upgrades, err := outdated.Upgrades(context.Background(), client, m.Requires, &outdated.UpgradeOptions{
+		Level:    level,
+		Indirect: indirect,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, u := range upgrades {
+		if u.Warning != "" {
+			fmt.Fprintln(stderr, "warning:", u.Warning)
+		}
+	}
+	if diff {
+		d, err := outdated.Diff(filename, data, upgrades)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(stdout, d)
+		return err
+	}
+	for _, c := range outdated.GoGetCommands(upgrades) {
+		fmt.Fprintln(stdout, c)
+	}
+	return nil
+}
+
+func printText(w io.Writer, report *outdated.Report, violations []outdated.Result) error {
+	failed := make(map[string]bool, len(violations))
+	for _, v := range violations {
+		failed[v.Path] = true
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MODULE\tCURRENT\tLATEST\tLAG\tPROJECT\t")
+	for _, r := range report.Results {
+		mark := ""
+		if failed[r.Path] {
+			mark = "!"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s%s\t%s/%s\t\n", r.Path, r.Version, r.Latest.Version, r.Lag, mark, r.Provider, r.Project)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(report.Untracked) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Untracked modules:")
+		for _, m := range report.Untracked {
+			fmt.Fprintf(w, "  %s %s\n", m.Path, m.Version)
+		}
+	}
+	return nil
+}
+
+func printJSON(w io.Writer, report *outdated.Report, violations []outdated.Result) error {
+	type module struct {
+		Path     string `json:"path"`
+		Version  string `json:"version"`
+		Indirect bool   `json:"indirect,omitempty"`
+	}
+	type result struct {
+		module
+		Provider  newreleases.Provider `json:"provider"`
+		Project   string               `json:"project"`
+		Latest    string               `json:"latest"`
+		Lag       string               `json:"lag"`
+		Violation bool                 `json:"violation,omitempty"`
+	}
+	type output struct {
+		Results   []result `json:"results"`
+		Untracked []module `json:"untracked"`
+	}
+
+	failed := make(map[string]bool, len(violations))
+	for _, v := range violations {
+		failed[v.Path] = true
+	}
+	o := output{
+		Results:   make([]result, 0, len(report.Results)),
+		Untracked: make([]module, 0, len(report.Untracked)),
+	}
+	for _, r := range report.Results {
+		o.Results = append(o.Results, result{
+			module:    module{Path: r.Path, Version: r.Version, Indirect: r.Indirect},
+			Provider:  r.Provider,
+			Project:   r.Project,
+			Latest:    r.Latest.Version,
+			Lag:       r.Lag.String(),
+			Violation: failed[r.Path],
+		})
+	}
+	for _, m := range report.Untracked {
+		o.Untracked = append(o.Untracked, module{Path: m.Path, Version: m.Version, Indirect: m.Indirect})
+	}
+
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(o)
+}
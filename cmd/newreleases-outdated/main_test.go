@@ -0,0 +1,172 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+const goMod = `module example.com/app
+
+go 1.21
+
+require (
+	example.com/major v1.0.0
+	example.com/patch v1.0.0
+	example.com/private v1.0.0
+)
+`
+
+func TestRun(t *testing.T) {
+	filename, opts := newServer(t)
+
+	for _, tc := range []struct {
+		name   string
+		args   []string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{
+			name:   "major lag fails by default",
+			args:   []string{filename},
+			code:   exitViolation,
+			stdout: "example.com/major  v1.0.0   v2.0.0  major!  go/example.com/major",
+		},
+		{
+			name:   "fail on minor",
+			args:   []string{"-fail-on", "minor", "-json", filename},
+			code:   exitViolation,
+			stdout: `"violation": true`,
+		},
+		{
+			name:   "fail on none",
+			args:   []string{"-fail-on", "none", "-json", filename},
+			stdout: `"lag": "major"`,
+		},
+		{
+			name:   "fail on none with untracked",
+			args:   []string{"-fail-on", "none", "-fail-untracked", "-json", filename},
+			code:   exitViolation,
+			stdout: `"path": "example.com/private"`,
+		},
+		{
+			name:   "invalid fail on",
+			args:   []string{"-fail-on", "huge", filename},
+			code:   exitError,
+			stderr: "error: ",
+		},
+		{
+			name:   "upgrade",
+			args:   []string{"-upgrade", "minor", filename},
+			stdout: "go get example.com/major@v1.1.0\ngo get example.com/patch@v1.0.1\n",
+		},
+		{
+			name:   "upgrade diff",
+			args:   []string{"-upgrade", "patch", "-diff", filename},
+			stdout: "+\texample.com/patch v1.0.1\n",
+		},
+		{
+			name:   "upgrade none",
+			args:   []string{"-upgrade", "none", filename},
+			code:   exitError,
+			stderr: "upgrade level must be patch, minor or major",
+		},
+		{
+			name:   "diff without upgrade",
+			args:   []string{"-diff", filename},
+			code:   exitError,
+			stderr: "error: -diff requires -upgrade\nusage: newreleases-outdated",
+		},
+		{
+			name:   "too many arguments",
+			args:   []string{filename, filename},
+			code:   exitError,
+			stderr: "usage: newreleases-outdated",
+		},
+		{
+			name:   "missing file",
+			args:   []string{filepath.Join(filepath.Dir(filename), "missing.mod")},
+			code:   exitError,
+			stderr: "error: ",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			code := run(append([]string{"-auth-key", "key"}, tc.args...), &stdout, &stderr, opts)
+
+			testutil.AssertEqual(t, "exit code", code, tc.code)
+			if tc.code == 0 && stderr.Len() > 0 {
+				t.Errorf("unexpected stderr %q", stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tc.stdout) {
+				t.Errorf("got stdout %q, want it to contain %q", stdout.String(), tc.stdout)
+			}
+			if !strings.Contains(stderr.String(), tc.stderr) {
+				t.Errorf("got stderr %q, want it to contain %q", stderr.String(), tc.stderr)
+			}
+		})
+	}
+}
+
+func TestRun_authKey(t *testing.T) {
+	filename, opts := newServer(t)
+
+	var stdout, stderr strings.Builder
+	code := run([]string{"-auth-key", "", filename}, &stdout, &stderr, opts)
+
+	testutil.AssertEqual(t, "exit code", code, exitError)
+	testutil.AssertEqual(t, "stderr", stderr.String(), "error: auth key is not set\n")
+}
+
+// newServer starts a test API server and returns the client options to use it
+// with run, and the name of a go.mod file with requirements of projects that it
+// serves.
+func newServer(t *testing.T) (filename string, opts *newreleases.ClientOptions) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts = &newreleases.ClientOptions{
+		BaseURL:    baseURL,
+		HTTPClient: server.Client(),
+	}
+
+	testutil.Handle(mux, "/v1/projects/go/example.com/major", `{"id": "major", "provider": "go", "name": "example.com/major"}`)
+	testutil.Handle(mux, "/v1/projects/major/latest-release", `{"version": "v2.0.0"}`)
+	testutil.Handle(mux, "/v1/projects/major/releases", `{"releases": [
+		{"version": "v2.0.0"},
+		{"version": "v1.1.0"},
+		{"version": "v1.0.0"}
+	], "total_pages": 1}`)
+	testutil.Handle(mux, "/v1/projects/go/example.com/patch", `{"id": "patch", "provider": "go", "name": "example.com/patch"}`)
+	testutil.Handle(mux, "/v1/projects/patch/latest-release", `{"version": "v1.0.1"}`)
+	testutil.Handle(mux, "/v1/projects/patch/releases", `{"releases": [
+		{"version": "v1.0.1"},
+		{"version": "v1.0.0"}
+	], "total_pages": 1}`)
+
+	filename = filepath.Join(t.TempDir(), "go.mod")
+	if err := os.WriteFile(filename, []byte(goMod), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return filename, opts
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cve generates reports of releases with CVE identifiers across
+// projects tracked on NewReleases, in Markdown, CSV, JSON and SARIF formats.
+package cve // import "newreleases.io/newreleases/cve"
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"newreleases.io/newreleases"
+)
+
+// Report holds CVE identifiers of releases aggregated per project.
+type Report struct {
+	// Since and Until are the date limits of included releases, zero if they
+	// are not set.
+	Since time.Time
+	Until time.Time
+	// Projects holds only projects with releases that have CVE identifiers,
+	// in the order they were listed.
+	Projects []Project
+}
+
+// Project holds releases of a project with CVE identifiers.
+type Project struct {
+	ID       string               `json:"id"`
+	Provider newreleases.Provider `json:"provider"`
+	Name     string               `json:"name"`
+	URL      string               `json:"url,omitempty"`
+	// CVEs holds sorted unique identifiers from all project releases.
+	CVEs     []string  `json:"cves"`
+	Releases []Release `json:"releases"`
+}
+
+// Release holds CVE identifiers of a release.
+type Release struct {
+	Version string    `json:"version"`
+	Date    time.Time `json:"date"`
+	CVEs    []string  `json:"cves"`
+}
+
+// CVEs returns sorted unique identifiers from all projects.
+func (r *Report) CVEs() (cves []string) {
+	var all []string
+	for _, p := range r.Projects {
+		all = append(all, p.CVEs...)
+	}
+	return unique(all)
+}
+
+// Options holds optional parameters for Generate.
+type Options struct {
+	// TagID limits the report to projects with the tag.
+	TagID string
+	// Provider limits the report to projects of the provider.
+	Provider newreleases.Provider
+	// Since and Until limit the report to releases published on or after
+	// Since and before Until, if they are not zero.
+	Since time.Time
+	Until time.Time
+}
+
+// Generate lists all tracked projects and their releases and returns the
+// report of releases with CVE identifiers. Releases are listed from the most
+// recent, so listing of project releases stops at the first page with all
+// releases published before Since.
+func Generate(ctx context.Context, client *newreleases.Client, o *Options) (r *Report, err error) {
+	if o == nil {
+		o = new(Options)
+	}
+	r = &Report{
+		Since: o.Since,
+		Until: o.Until,
+	}
+	err = client.Projects.Walk(ctx, newreleases.ProjectListOptions{
+		Provider: o.Provider,
+		TagID:    o.TagID,
+	}, func(p newreleases.Project) (stop bool, err error) {
+		project, err := generateProject(ctx, client, p, o)
+		if err != nil {
+			return false, err
+		}
+		if len(project.Releases) > 0 {
+			r.Projects = append(r.Projects, project)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func generateProject(ctx context.Context, client *newreleases.Client, p newreleases.Project, o *Options) (project Project, err error) {
+	project = Project{
+		ID:       p.ID,
+		Provider: p.Provider,
+		Name:     p.Name,
+		URL:      p.URL,
+	}
+	if project.URL == "" {
+		// Locations in SARIF results require project addresses.
+		project.URL, _ = newreleases.ProjectURL(p.Provider, p.Name)
+	}
+	err = client.Releases.WalkSince(ctx, newreleases.ProjectRefByID(p.ID), o.Since, 0, func(r newreleases.Release) (stop bool, err error) {
+		if len(r.CVE) == 0 || !inRange(r.Date, o) {
+			return false, nil
+		}
+		cves := unique(r.CVE)
+		project.Releases = append(project.Releases, Release{
+			Version: r.Version,
+			Date:    r.Date,
+			CVEs:    cves,
+		})
+		project.CVEs = append(project.CVEs, cves...)
+		return false, nil
+	})
+	if err != nil {
+		return Project{}, err
+	}
+	project.CVEs = unique(project.CVEs)
+	return project, nil
+}
+
+func inRange(t time.Time, o *Options) bool {
+	if !o.Since.IsZero() && t.Before(o.Since) {
+		return false
+	}
+	if !o.Until.IsZero() && !t.Before(o.Until) {
+		return false
+	}
+	return true
+}
+
+// unique returns sorted strings without duplicates.
+func unique(s []string) (u []string) {
+	if len(s) == 0 {
+		return nil
+	}
+	sorted := append([]string(nil), s...)
+	sort.Strings(sorted)
+	for i, v := range sorted {
+		if i == 0 || v != sorted[i-1] {
+			u = append(u, v)
+		}
+	}
+	return u
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"newreleases.io/newreleases/cve"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestGenerate(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	var requested []string
+	handlePages(mux, "/v1/projects", &requested, `{"projects": [
+		{"id": "node", "provider": "github", "name": "nodejs/node", "url": "https://github.com/nodejs/node"}
+	], "total_pages": 2}`, `{"projects": [
+		{"id": "curl", "provider": "github", "name": "curl/curl"},
+		{"id": "jq", "provider": "github", "name": "jqlang/jq"}
+	], "total_pages": 2}`)
+	handlePages(mux, "/v1/projects/node/releases", &requested, `{"releases": [
+		{"version": "v20.5.1", "date": "2023-08-09T00:00:00Z", "cve": ["CVE-2023-32559", "CVE-2023-32002", "CVE-2023-32559"]},
+		{"version": "v20.5.0", "date": "2023-07-21T00:00:00Z"}
+	], "total_pages": 2}`, `{"releases": [
+		{"version": "v20.3.1", "date": "2023-06-20T00:00:00Z", "cve": ["CVE-2023-30581"]}
+	], "total_pages": 2}`)
+	handlePages(mux, "/v1/projects/curl/releases", &requested, `{"releases": [
+		{"version": "curl-8_4_0", "date": "2023-10-11T00:00:00Z", "cve": ["CVE-2023-38545", "CVE-2023-38546"]}
+	], "total_pages": 1}`)
+	handlePages(mux, "/v1/projects/jq/releases", &requested, `{"releases": [
+		{"version": "jq-1.7", "date": "2023-09-07T00:00:00Z"}
+	], "total_pages": 1}`)
+
+	t.Run("all", func(t *testing.T) {
+		requested = nil
+
+		got, err := cve.Generate(context.Background(), client, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testutil.AssertEqual(t, "report", got, &cve.Report{
+			Projects: []cve.Project{
+				{
+					ID:       "node",
+					Provider: "github",
+					Name:     "nodejs/node",
+					URL:      "https://github.com/nodejs/node",
+					CVEs:     []string{"CVE-2023-30581", "CVE-2023-32002", "CVE-2023-32559"},
+					Releases: []cve.Release{
+						{Version: "v20.5.1", Date: testutil.Date("2023-08-09"), CVEs: []string{"CVE-2023-32002", "CVE-2023-32559"}},
+						{Version: "v20.3.1", Date: testutil.Date("2023-06-20"), CVEs: []string{"CVE-2023-30581"}},
+					},
+				},
+				{
+					ID:       "curl",
+					Provider: "github",
+					Name:     "curl/curl",
+					URL:      "https://github.com/curl/curl/releases",
+					CVEs:     []string{"CVE-2023-38545", "CVE-2023-38546"},
+					Releases: []cve.Release{
+						{Version: "curl-8_4_0", Date: testutil.Date("2023-10-11"), CVEs: []string{"CVE-2023-38545", "CVE-2023-38546"}},
+					},
+				},
+			},
+		})
+		testutil.AssertEqual(t, "cves", got.CVEs(), []string{"CVE-2023-30581", "CVE-2023-32002", "CVE-2023-32559", "CVE-2023-38545", "CVE-2023-38546"})
+	})
+
+	t.Run("date range", func(t *testing.T) {
+		requested = nil
+
+		got, err := cve.Generate(context.Background(), client, &cve.Options{
+			TagID: "security",
+			Since: testutil.Date("2023-07-01"),
+			Until: testutil.Date("2023-10-01"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testutil.AssertEqual(t, "projects", len(got.Projects), 1)
+		testutil.AssertEqual(t, "releases", got.Projects[0].Releases, []cve.Release{
+			{Version: "v20.5.1", Date: testutil.Date("2023-08-09"), CVEs: []string{"CVE-2023-32002", "CVE-2023-32559"}},
+		})
+		testutil.AssertEqual(t, "requests", requested, []string{
+			"/v1/projects?tag=security",
+			"/v1/projects/node/releases",
+			"/v1/projects/node/releases?page=2",
+			"/v1/projects?page=2&tag=security",
+			"/v1/projects/curl/releases",
+			"/v1/projects/jq/releases",
+		})
+	})
+}
+
+func handlePages(mux *http.ServeMux, path string, requested *[]string, pages ...string) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		*requested = append(*requested, r.URL.RequestURI())
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			fmt.Sscan(p, &page)
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintln(w, pages[page-1])
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/render"
+)
+
+// WriteMarkdown writes the report as a Markdown document with a summary
+// table and a table of releases for every project.
+func (r *Report) WriteMarkdown(w io.Writer) (err error) {
+	var b strings.Builder
+	b.WriteString("# CVE report\n\n")
+	switch {
+	case !r.Since.IsZero() && !r.Until.IsZero():
+		fmt.Fprintf(&b, "Releases from %s to %s.\n\n", r.Since.Format(render.DateFormat), r.Until.Format(render.DateFormat))
+	case !r.Since.IsZero():
+		fmt.Fprintf(&b, "Releases since %s.\n\n", r.Since.Format(render.DateFormat))
+	case !r.Until.IsZero():
+		fmt.Fprintf(&b, "Releases until %s.\n\n", r.Until.Format(render.DateFormat))
+	}
+	if len(r.Projects) == 0 {
+		b.WriteString("No releases with CVEs.\n")
+		_, err = io.WriteString(w, b.String())
+		return err
+	}
+
+	b.WriteString("| Project | Releases | CVEs |\n|---|---|---|\n")
+	for _, p := range r.Projects {
+		fmt.Fprintf(&b, "| %s | %d | %s |\n", render.EscapeMarkdown(string(p.Provider)+"/"+p.Name), len(p.Releases), markdownCVEs(p.CVEs))
+	}
+	for _, p := range r.Projects {
+		name := render.EscapeMarkdown(string(p.Provider) + "/" + p.Name)
+		if p.URL != "" {
+			name = "[" + name + "](" + p.URL + ")"
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n| Version | Date | CVEs |\n|---|---|---|\n", name)
+		for _, rel := range p.Releases {
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", render.EscapeMarkdown(rel.Version), rel.Date.Format(render.DateFormat), markdownCVEs(rel.CVEs))
+		}
+	}
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+func markdownCVEs(cves []string) string {
+	links := make([]string, len(cves))
+	for i, id := range cves {
+		links[i] = "[" + render.EscapeMarkdown(id) + "](" + newreleases.CVEURL(id) + ")"
+	}
+	return strings.Join(links, ", ")
+}
+
+// WriteCSV writes the report as CSV with a header and a record for every CVE
+// identifier of every release.
+func (r *Report) WriteCSV(w io.Writer) (err error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"provider", "project", "version", "date", "cve"}); err != nil {
+		return err
+	}
+	for _, p := range r.Projects {
+		for _, rel := range p.Releases {
+			for _, id := range rel.CVEs {
+				if err := cw.Write([]string{string(p.Provider), p.Name, rel.Version, rel.Date.Format(time.RFC3339), id}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the report as an indented JSON object.
+func (r *Report) WriteJSON(w io.Writer) (err error) {
+	type report struct {
+		Since    *time.Time `json:"since,omitempty"`
+		Until    *time.Time `json:"until,omitempty"`
+		CVEs     []string   `json:"cves"`
+		Projects []Project  `json:"projects"`
+	}
+	o := report{
+		CVEs:     r.CVEs(),
+		Projects: r.Projects,
+	}
+	if !r.Since.IsZero() {
+		o.Since = &r.Since
+	}
+	if !r.Until.IsZero() {
+		o.Until = &r.Until
+	}
+	if o.CVEs == nil {
+		o.CVEs = []string{}
+	}
+	if o.Projects == nil {
+		o.Projects = []Project{}
+	}
+
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(o)
+}
+
+// WriteSARIF writes the report in the SARIF 2.1.0 format, with a rule for
+// every CVE identifier and a result for every CVE of every release, located
+// at the project URL.
+func (r *Report) WriteSARIF(w io.Writer) (err error) {
+	type message struct {
+		Text string `json:"text"`
+	}
+	type rule struct {
+		ID               string  `json:"id"`
+		ShortDescription message `json:"shortDescription"`
+		HelpURI          string  `json:"helpUri"`
+	}
+	type artifactLocation struct {
+		URI string `json:"uri"`
+	}
+	type physicalLocation struct {
+		ArtifactLocation artifactLocation `json:"artifactLocation"`
+	}
+	type location struct {
+		PhysicalLocation physicalLocation `json:"physicalLocation"`
+	}
+	type result struct {
+		RuleID     string            `json:"ruleId"`
+		Level      string            `json:"level"`
+		Message    message           `json:"message"`
+		Locations  []location        `json:"locations,omitempty"`
+		Properties map[string]string `json:"properties"`
+	}
+	type driver struct {
+		Name           string `json:"name"`
+		InformationURI string `json:"informationUri"`
+		Rules          []rule `json:"rules"`
+	}
+	type tool struct {
+		Driver driver `json:"driver"`
+	}
+	type run struct {
+		Tool    tool     `json:"tool"`
+		Results []result `json:"results"`
+	}
+	type log struct {
+		Schema  string `json:"$schema"`
+		Version string `json:"version"`
+		Runs    []run  `json:"runs"`
+	}
+
+	cves := r.CVEs()
+	rules := make([]rule, len(cves))
+	for i, id := range cves {
+		rules[i] = rule{
+			ID:               id,
+			ShortDescription: message{Text: id},
+			HelpURI:          newreleases.CVEURL(id),
+		}
+	}
+	results := make([]result, 0)
+	for _, p := range r.Projects {
+		name := string(p.Provider) + "/" + p.Name
+		var locations []location
+		if p.URL != "" {
+			locations = []location{{PhysicalLocation: physicalLocation{ArtifactLocation: artifactLocation{URI: p.URL}}}}
+		}
+		for _, rel := range p.Releases {
+			for _, id := range rel.CVEs {
+				results = append(results, result{
+					RuleID:    id,
+					Level:     "warning",
+					Message:   message{Text: fmt.Sprintf("%s %s references %s", name, rel.Version, id)},
+					Locations: locations,
+					Properties: map[string]string{
+						"provider": string(p.Provider),
+						"project":  p.Name,
+						"version":  rel.Version,
+						"date":     rel.Date.Format(time.RFC3339),
+					},
+				})
+			}
+		}
+	}
+
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(log{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []run{{
+			Tool: tool{Driver: driver{
+				Name:           "newreleases",
+				InformationURI: "https://newreleases.io",
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases/cve"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+var formatReport = &cve.Report{
+	Since: testutil.Date("2023-01-01"),
+	Projects: []cve.Project{
+		{
+			ID:       "node",
+			Provider: "github",
+			Name:     "nodejs/node",
+			URL:      "https://github.com/nodejs/node",
+			CVEs:     []string{"CVE-2023-30581", "CVE-2023-32002"},
+			Releases: []cve.Release{
+				{Version: "v20.5.1", Date: testutil.Date("2023-08-09"), CVEs: []string{"CVE-2023-32002"}},
+				{Version: "v20.3.1", Date: testutil.Date("2023-06-20"), CVEs: []string{"CVE-2023-30581"}},
+			},
+		},
+		{
+			ID:       "curl",
+			Provider: "github",
+			Name:     "curl/curl",
+			CVEs:     []string{"CVE-2023-38545"},
+			Releases: []cve.Release{
+				{Version: "curl-8_4_0", Date: testutil.Date("2023-10-11"), CVEs: []string{"CVE-2023-38545"}},
+			},
+		},
+	},
+}
+
+func TestReport_WriteMarkdown(t *testing.T) {
+	var b strings.Builder
+	if err := formatReport.WriteMarkdown(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", b.String(), `# CVE report
+
+Releases since 2023-01-01.
+
+| Project | Releases | CVEs |
+|---|---|---|
+| github/nodejs/node | 2 | [CVE-2023-30581](https://nvd.nist.gov/vuln/detail/CVE-2023-30581), [CVE-2023-32002](https://nvd.nist.gov/vuln/detail/CVE-2023-32002) |
+| github/curl/curl | 1 | [CVE-2023-38545](https://nvd.nist.gov/vuln/detail/CVE-2023-38545) |
+
+## [github/nodejs/node](https://github.com/nodejs/node)
+
+| Version | Date | CVEs |
+|---|---|---|
+| v20.5.1 | 2023-08-09 | [CVE-2023-32002](https://nvd.nist.gov/vuln/detail/CVE-2023-32002) |
+| v20.3.1 | 2023-06-20 | [CVE-2023-30581](https://nvd.nist.gov/vuln/detail/CVE-2023-30581) |
+
+## github/curl/curl
+
+| Version | Date | CVEs |
+|---|---|---|
+| curl-8_4_0 | 2023-10-11 | [CVE-2023-38545](https://nvd.nist.gov/vuln/detail/CVE-2023-38545) |
+`)
+
+	b.Reset()
+	if err := new(cve.Report).WriteMarkdown(&b); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "empty", b.String(), "# CVE report\n\nNo releases with CVEs.\n")
+}
+
+func TestReport_WriteCSV(t *testing.T) {
+	var b strings.Builder
+	if err := formatReport.WriteCSV(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", b.String(), `provider,project,version,date,cve
+github,nodejs/node,v20.5.1,2023-08-09T00:00:00Z,CVE-2023-32002
+github,nodejs/node,v20.3.1,2023-06-20T00:00:00Z,CVE-2023-30581
+github,curl/curl,curl-8_4_0,2023-10-11T00:00:00Z,CVE-2023-38545
+`)
+}
+
+func TestReport_WriteJSON(t *testing.T) {
+	var b strings.Builder
+	if err := formatReport.WriteJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	var got struct {
+		Since    string        `json:"since"`
+		Until    *string       `json:"until"`
+		CVEs     []string      `json:"cves"`
+		Projects []cve.Project `json:"projects"`
+	}
+	if err := json.Unmarshal([]byte(b.String()), &got); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "since", got.Since, "2023-01-01T00:00:00Z")
+	testutil.AssertEqual(t, "until", got.Until, (*string)(nil))
+	testutil.AssertEqual(t, "cves", got.CVEs, []string{"CVE-2023-30581", "CVE-2023-32002", "CVE-2023-38545"})
+	testutil.AssertEqual(t, "projects", got.Projects, formatReport.Projects)
+}
+
+func TestReport_WriteSARIF(t *testing.T) {
+	var b strings.Builder
+	if err := formatReport.WriteSARIF(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	var got struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Name  string `json:"name"`
+					Rules []struct {
+						ID      string `json:"id"`
+						HelpURI string `json:"helpUri"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID  string `json:"ruleId"`
+				Message struct {
+					Text string `json:"text"`
+				} `json:"message"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+				Properties map[string]string `json:"properties"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal([]byte(b.String()), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "version", got.Version, "2.1.0")
+	testutil.AssertEqual(t, "runs", len(got.Runs), 1)
+	run := got.Runs[0]
+	testutil.AssertEqual(t, "tool", run.Tool.Driver.Name, "newreleases")
+	testutil.AssertEqual(t, "rules", len(run.Tool.Driver.Rules), 3)
+	testutil.AssertEqual(t, "rule", run.Tool.Driver.Rules[0].ID, "CVE-2023-30581")
+	testutil.AssertEqual(t, "rule help", run.Tool.Driver.Rules[0].HelpURI, "https://nvd.nist.gov/vuln/detail/CVE-2023-30581")
+	testutil.AssertEqual(t, "results", len(run.Results), 3)
+	testutil.AssertEqual(t, "result rule", run.Results[0].RuleID, "CVE-2023-32002")
+	testutil.AssertEqual(t, "result message", run.Results[0].Message.Text, "github/nodejs/node v20.5.1 references CVE-2023-32002")
+	testutil.AssertEqual(t, "result location", run.Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI, "https://github.com/nodejs/node")
+	testutil.AssertEqual(t, "result version", run.Results[0].Properties["version"], "v20.5.1")
+	testutil.AssertEqual(t, "result without location", len(run.Results[2].Locations), 0)
+}
@@ -13,13 +13,51 @@ import (
 // BadRequestError holds list of errors from http response that represent
 // invalid data submitted by the user.
 type BadRequestError struct {
-	errors []string
+	errors  []string
+	details []BadRequestDetail
 }
 
+// BadRequestDetail holds a single error message from a bad request response,
+// the name of the field that it refers to, if the API provided it, and the
+// classified reason of the error.
+type BadRequestDetail struct {
+	Message string
+	Field   string
+	// Reason is one of the ErrProjectAlreadyExists, ErrUnknownProvider,
+	// ErrInvalidRegexp, ErrUnknownChannelID and ErrInvalidTag errors, or nil
+	// if the message could not be classified.
+	Reason error
+}
+
+// Errors that classify bad request error messages. They can be checked with
+// errors.Is on BadRequestError, while ErrUnknownProvider is also returned by
+// Provider validation.
+var (
+	ErrProjectAlreadyExists = errors.New("project already exists")
+	ErrInvalidRegexp        = errors.New("invalid regular expression")
+	ErrUnknownChannelID     = errors.New("unknown channel id")
+	ErrInvalidTag           = errors.New("invalid tag")
+)
+
 // NewBadRequestError constructs a new BadRequestError with provided errors.
 func NewBadRequestError(errors ...string) (err *BadRequestError) {
+	details := make([]BadRequestDetail, len(errors))
+	for i, e := range errors {
+		details[i] = BadRequestDetail{Message: e}
+	}
+	return newBadRequestError(details...)
+}
+
+// newBadRequestError constructs a new BadRequestError with classified details.
+func newBadRequestError(details ...BadRequestDetail) (err *BadRequestError) {
+	errors := make([]string, len(details))
+	for i := range details {
+		details[i].Reason = classifyBadRequest(details[i].Message, details[i].Field)
+		errors[i] = details[i].Message
+	}
 	return &BadRequestError{
-		errors: errors,
+		errors:  errors,
+		details: details,
 	}
 }
 
@@ -32,6 +70,62 @@ func (e *BadRequestError) Errors() (errs []string) {
 	return e.errors
 }
 
+// Details returns error messages with their field names and classified
+// reasons.
+func (e *BadRequestError) Details() (details []BadRequestDetail) {
+	return e.details
+}
+
+// Is reports whether any of the error messages is classified as the target
+// error, allowing checks like errors.Is(err, ErrProjectAlreadyExists).
+func (e *BadRequestError) Is(target error) bool {
+	for _, d := range e.details {
+		if d.Reason != nil && d.Reason == target {
+			return true
+		}
+	}
+	return false
+}
+
+// classifyBadRequest returns the reason for an error message by the field that
+// it refers to or by the message content. Errors for the provider field are
+// classified by the message content only, as not all of them are about an
+// unknown provider.
+func classifyBadRequest(message, field string) (reason error) {
+	switch field {
+	case "exclude_version_regexp":
+		return ErrInvalidRegexp
+	case "tags":
+		return ErrInvalidTag
+	case "slack_channels", "telegram_chats", "discord_channels", "hangouts_chat_webhooks",
+		"microsoft_teams_webhooks", "mattermost_webhooks", "rocketchat_webhooks", "matrix_rooms", "webhooks":
+		return ErrUnknownChannelID
+	}
+
+	m := strings.ToLower(message)
+	has := func(words ...string) bool {
+		for _, w := range words {
+			if strings.Contains(m, w) {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case has("already") && has("exist", "track", "added"):
+		return ErrProjectAlreadyExists
+	case (field == "provider" || has("provider")) && has("unknown", "invalid", "unsupported", "not supported"):
+		return ErrUnknownProvider
+	case has("regexp", "regular expression"):
+		return ErrInvalidRegexp
+	case has("channel", "chat", "webhook", "room") && has("unknown", "invalid", "not found"):
+		return ErrUnknownChannelID
+	case has("tag") && has("unknown", "invalid", "not found"):
+		return ErrInvalidTag
+	}
+	return nil
+}
+
 // Errors that are returned by the API.
 var (
 	ErrUnauthorized        = errors.New("unauthorized")
@@ -43,4 +137,7 @@ var (
 	ErrMaintenance         = errors.New("maintenance")
 )
 
-var errInvalidPageNumber = errors.New("invalid page number")
+var (
+	errInvalidPageNumber = errors.New("invalid page number")
+	errInvalidProjectRef = errors.New("invalid project reference")
+)
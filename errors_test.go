@@ -0,0 +1,122 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"newreleases.io/newreleases"
+)
+
+func TestBadRequestError(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	mux.HandleFunc("/v1/projects", requireMethod("POST", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", jsonContentType)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{
+			"errors": [
+				"Project already exists.",
+				{"field": "exclude_version_regexp", "message": "error parsing regexp: missing closing ): ` + "`(a`" + `"},
+				"Unknown Slack channel ID.",
+				"Something else."
+			]
+		}`))
+	}))
+
+	_, err := client.Projects.Add(context.Background(), "github", "golang/go", nil)
+
+	var e *newreleases.BadRequestError
+	if !errors.As(err, &e) {
+		t.Fatalf("got error %v, want bad request error", err)
+	}
+	assertEqual(t, "errors", e.Errors(), []string{
+		"Project already exists.",
+		"error parsing regexp: missing closing ): `(a`",
+		"Unknown Slack channel ID.",
+		"Something else.",
+	})
+	assertEqual(t, "details", e.Details(), []newreleases.BadRequestDetail{
+		{Message: "Project already exists.", Reason: newreleases.ErrProjectAlreadyExists},
+		{Message: "error parsing regexp: missing closing ): `(a`", Field: "exclude_version_regexp", Reason: newreleases.ErrInvalidRegexp},
+		{Message: "Unknown Slack channel ID.", Reason: newreleases.ErrUnknownChannelID},
+		{Message: "Something else."},
+	})
+	for _, target := range []error{
+		newreleases.ErrProjectAlreadyExists,
+		newreleases.ErrInvalidRegexp,
+		newreleases.ErrUnknownChannelID,
+	} {
+		if !errors.Is(err, target) {
+			t.Errorf("error is not %v", target)
+		}
+	}
+	for _, target := range []error{
+		newreleases.ErrUnknownProvider,
+		newreleases.ErrInvalidTag,
+	} {
+		if errors.Is(err, target) {
+			t.Errorf("error is %v", target)
+		}
+	}
+}
+
+func TestBadRequestError_provider(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	mux.HandleFunc("/v1/projects", requireMethod("POST", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", jsonContentType)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{
+			"errors": [
+				{"field": "provider", "message": "This field is required."},
+				{"field": "provider", "message": "Unknown value."}
+			]
+		}`))
+	}))
+
+	_, err := client.Projects.Add(context.Background(), "", "golang/go", nil)
+
+	var e *newreleases.BadRequestError
+	if !errors.As(err, &e) {
+		t.Fatalf("got error %v, want bad request error", err)
+	}
+	assertEqual(t, "details", e.Details(), []newreleases.BadRequestDetail{
+		{Message: "This field is required.", Field: "provider"},
+		{Message: "Unknown value.", Field: "provider", Reason: newreleases.ErrUnknownProvider},
+	})
+}
+
+func TestNewBadRequestError(t *testing.T) {
+	for _, tc := range []struct {
+		message string
+		reason  error
+	}{
+		{message: "Project is already tracked.", reason: newreleases.ErrProjectAlreadyExists},
+		{message: "Unknown provider.", reason: newreleases.ErrUnknownProvider},
+		{message: "Invalid regular expression.", reason: newreleases.ErrInvalidRegexp},
+		{message: "Webhook not found.", reason: newreleases.ErrUnknownChannelID},
+		{message: "Invalid Telegram chat.", reason: newreleases.ErrUnknownChannelID},
+		{message: "Unknown tag.", reason: newreleases.ErrInvalidTag},
+		{message: "Invalid name."},
+	} {
+		t.Run(tc.message, func(t *testing.T) {
+			err := newreleases.NewBadRequestError(tc.message)
+			assertEqual(t, "error", err.Error(), tc.message)
+			assertEqual(t, "details", err.Details(), []newreleases.BadRequestDetail{
+				{Message: tc.message, Reason: tc.reason},
+			})
+			if tc.reason != nil && !errors.Is(err, tc.reason) {
+				t.Errorf("error is not %v", tc.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// ExclusionRules holds project settings that exclude releases from
+// notifications. They can be evaluated locally against release history before
+// they are applied to the project.
+type ExclusionRules struct {
+	Exclusions         []Exclusion
+	ExcludePrereleases bool
+	ExcludeUpdated     bool
+}
+
+// ExclusionRules returns exclusion rules that are currently configured for the
+// project.
+func (p *Project) ExclusionRules() (r ExclusionRules) {
+	return ExclusionRules{
+		Exclusions:         p.Exclusions,
+		ExcludePrereleases: p.ExcludePrereleases,
+		ExcludeUpdated:     p.ExcludeUpdated,
+	}
+}
+
+// ProjectOptions returns project options that set the exclusion rules with
+// ProjectsService update methods. Other options are not changed.
+func (r ExclusionRules) ProjectOptions() (o *ProjectOptions) {
+	exclusions := r.Exclusions
+	if exclusions == nil {
+		exclusions = make([]Exclusion, 0)
+	}
+	return &ProjectOptions{
+		Exclusions:         exclusions,
+		ExcludePrereleases: Bool(r.ExcludePrereleases),
+		ExcludeUpdated:     Bool(r.ExcludeUpdated),
+	}
+}
+
+// ExclusionMatcher decides which releases are excluded by compiled exclusion
+// rules.
+type ExclusionMatcher struct {
+	rules   ExclusionRules
+	regexps []*regexp.Regexp
+}
+
+// Compile compiles regular expressions of all exclusions. The returned error
+// wraps ErrInvalidRegexp if any of the expressions is not valid.
+func (r ExclusionRules) Compile() (m *ExclusionMatcher, err error) {
+	m = &ExclusionMatcher{
+		rules:   r,
+		regexps: make([]*regexp.Regexp, len(r.Exclusions)),
+	}
+	for i, e := range r.Exclusions {
+		m.regexps[i], err = regexp.Compile(e.Value)
+		if err != nil {
+			return nil, fmt.Errorf("exclusion %q: %w: %v", e.Value, ErrInvalidRegexp, err)
+		}
+	}
+	return m, nil
+}
+
+// Excludes reports whether the release is excluded. A release is excluded if
+// its version matches any of the exclusion regular expressions, or does not
+// match an inverse one, if it is a prerelease and prereleases are excluded, or
+// if it is updated and updated releases are excluded.
+func (m *ExclusionMatcher) Excludes(r Release) bool {
+	if m.rules.ExcludePrereleases && r.IsPrerelease {
+		return true
+	}
+	if m.rules.ExcludeUpdated && r.IsUpdated {
+		return true
+	}
+	for i, e := range m.rules.Exclusions {
+		if m.regexps[i].MatchString(r.Version) != e.Inverse {
+			return true
+		}
+	}
+	return false
+}
+
+// ExclusionResult holds the outcome of exclusion rules for a single release.
+type ExclusionResult struct {
+	Release Release
+	// Excluded reports whether the release would be excluded by the evaluated
+	// rules, while Release.IsExcluded holds the current state.
+	Excluded bool
+}
+
+// Changed reports whether the evaluated rules change the exclusion state of
+// the release.
+func (r ExclusionResult) Changed() bool {
+	return r.Excluded != r.Release.IsExcluded
+}
+
+// ExclusionPreview holds results of evaluating exclusion rules against
+// releases.
+type ExclusionPreview struct {
+	Results []ExclusionResult
+}
+
+// Excluded returns releases that would be excluded.
+func (p *ExclusionPreview) Excluded() (releases []Release) {
+	return p.filter(func(r ExclusionResult) bool { return r.Excluded })
+}
+
+// Kept returns releases that would not be excluded.
+func (p *ExclusionPreview) Kept() (releases []Release) {
+	return p.filter(func(r ExclusionResult) bool { return !r.Excluded })
+}
+
+// NewlyExcluded returns releases that are currently not excluded, but would be
+// with the evaluated rules.
+func (p *ExclusionPreview) NewlyExcluded() (releases []Release) {
+	return p.filter(func(r ExclusionResult) bool { return r.Excluded && !r.Release.IsExcluded })
+}
+
+// NewlyKept returns releases that are currently excluded, but would not be
+// with the evaluated rules.
+func (p *ExclusionPreview) NewlyKept() (releases []Release) {
+	return p.filter(func(r ExclusionResult) bool { return !r.Excluded && r.Release.IsExcluded })
+}
+
+func (p *ExclusionPreview) filter(f func(r ExclusionResult) bool) (releases []Release) {
+	for _, r := range p.Results {
+		if f(r) {
+			releases = append(releases, r.Release)
+		}
+	}
+	return releases
+}
+
+// Evaluate applies the exclusion rules to releases and compares the outcome
+// with their current IsExcluded flags.
+func (r ExclusionRules) Evaluate(releases []Release) (p *ExclusionPreview, err error) {
+	m, err := r.Compile()
+	if err != nil {
+		return nil, err
+	}
+	p = &ExclusionPreview{
+		Results: make([]ExclusionResult, len(releases)),
+	}
+	for i, release := range releases {
+		p.Results[i] = ExclusionResult{
+			Release:  release,
+			Excluded: m.Excludes(release),
+		}
+	}
+	return p, nil
+}
+
+// PreviewExclusions evaluates exclusion rules against the release history of a
+// project, listing at most maxPages pages of releases, or all of them if
+// maxPages is not greater than zero. It allows previewing the effect of rules
+// before they are applied with ProjectsService update methods.
+func (s *ReleasesService) PreviewExclusions(ctx context.Context, ref ProjectRef, rules ExclusionRules, maxPages int) (p *ExclusionPreview, err error) {
+	if _, err := rules.Compile(); err != nil {
+		return nil, err
+	}
+	releases, err := s.ListAll(ctx, ref, maxPages)
+	if err != nil {
+		return nil, err
+	}
+	return rules.Evaluate(releases)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"newreleases.io/newreleases"
+)
+
+func TestExclusionRules_Evaluate(t *testing.T) {
+	releases := []newreleases.Release{
+		{Version: "v2.0.0-rc.1", IsPrerelease: true},
+		{Version: "v1.2.0", IsUpdated: true},
+		{Version: "v1.1.0", IsExcluded: true},
+		{Version: "v0.9.0"},
+		{Version: "nightly-20230901"},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		rules         newreleases.ExclusionRules
+		excluded      []string
+		newlyExcluded []string
+		newlyKept     []string
+	}{
+		{
+			name:      "none",
+			newlyKept: []string{"v1.1.0"},
+		},
+		{
+			name: "prereleases",
+			rules: newreleases.ExclusionRules{
+				ExcludePrereleases: true,
+			},
+			excluded:      []string{"v2.0.0-rc.1"},
+			newlyExcluded: []string{"v2.0.0-rc.1"},
+			newlyKept:     []string{"v1.1.0"},
+		},
+		{
+			name: "updated",
+			rules: newreleases.ExclusionRules{
+				ExcludeUpdated: true,
+			},
+			excluded:      []string{"v1.2.0"},
+			newlyExcluded: []string{"v1.2.0"},
+			newlyKept:     []string{"v1.1.0"},
+		},
+		{
+			name: "regexp",
+			rules: newreleases.ExclusionRules{
+				Exclusions: []newreleases.Exclusion{
+					{Value: `^v0\.`},
+					{Value: `^nightly`},
+				},
+			},
+			excluded:      []string{"v0.9.0", "nightly-20230901"},
+			newlyExcluded: []string{"v0.9.0", "nightly-20230901"},
+			newlyKept:     []string{"v1.1.0"},
+		},
+		{
+			name: "inverse",
+			rules: newreleases.ExclusionRules{
+				Exclusions: []newreleases.Exclusion{
+					{Value: `^v1\.`, Inverse: true},
+				},
+			},
+			excluded:      []string{"v2.0.0-rc.1", "v0.9.0", "nightly-20230901"},
+			newlyExcluded: []string{"v2.0.0-rc.1", "v0.9.0", "nightly-20230901"},
+			newlyKept:     []string{"v1.1.0"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := tc.rules.Evaluate(releases)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "excluded", versions(p.Excluded()), tc.excluded)
+			assertEqual(t, "newly excluded", versions(p.NewlyExcluded()), tc.newlyExcluded)
+			assertEqual(t, "newly kept", versions(p.NewlyKept()), tc.newlyKept)
+			assertEqual(t, "total", len(p.Excluded())+len(p.Kept()), len(releases))
+		})
+	}
+}
+
+func TestExclusionRules_Evaluate_invalidRegexp(t *testing.T) {
+	_, err := newreleases.ExclusionRules{
+		Exclusions: []newreleases.Exclusion{{Value: `(beta`}},
+	}.Evaluate(nil)
+	if !errors.Is(err, newreleases.ErrInvalidRegexp) {
+		t.Fatalf("got error %v, want %v", err, newreleases.ErrInvalidRegexp)
+	}
+}
+
+func TestExclusionRules_ProjectOptions(t *testing.T) {
+	p := &newreleases.Project{
+		ExcludePrereleases: true,
+	}
+
+	assertEqual(t, "", p.ExclusionRules().ProjectOptions(), &newreleases.ProjectOptions{
+		Exclusions:         []newreleases.Exclusion{},
+		ExcludePrereleases: newreleases.Bool(true),
+		ExcludeUpdated:     newreleases.Bool(false),
+	})
+}
+
+func TestReleasesService_PreviewExclusions(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	mux.HandleFunc("/v1/projects/8wdvh4w9bhsvzclz4ynaqpcpvg/releases", requireMethod("GET", newPagedStaticHandler(releasesServiceList...)))
+
+	p, err := client.Releases.PreviewExclusions(context.Background(), newreleases.ProjectRefByID("8wdvh4w9bhsvzclz4ynaqpcpvg"), newreleases.ExclusionRules{
+		Exclusions:         []newreleases.Exclusion{{Value: `^v(6|8)\.`}},
+		ExcludePrereleases: true,
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertEqual(t, "excluded", versions(p.Excluded()), []string{"v11.12.0", "v6.17.0", "v8.15.1"})
+	assertEqual(t, "kept", versions(p.Kept()), []string{"v11.11.0", "v10.15.3"})
+	assertEqual(t, "newly excluded", versions(p.NewlyExcluded()), []string{"v11.12.0", "v8.15.1"})
+	assertEqual(t, "newly kept", versions(p.NewlyKept()), []string(nil))
+}
+
+func versions(releases []newreleases.Release) (v []string) {
+	for _, r := range releases {
+		v = append(v, r.Version)
+	}
+	return v
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package feed generates Atom 1.0, RSS 2.0 and JSON Feed documents from
+// recent releases of projects tracked on NewReleases, and serves them over
+// HTTP with caching headers.
+package feed // import "newreleases.io/newreleases/feed"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/render"
+)
+
+// DefaultLimit is the number of feed entries if it is not set in Options.
+const DefaultLimit = 50
+
+// idPrefix is the prefix of tag URIs (RFC 4151) used as feed and entry
+// identifiers, which do not change when a feed is generated again.
+const idPrefix = "tag:newreleases.io,2019:"
+
+// Feed holds the most recent releases, ordered from the most recent.
+type Feed struct {
+	// ID is a stable feed identifier that depends on the selected projects.
+	ID    string
+	Title string
+	// Link is the address of the website that the feed refers to.
+	Link string
+	// URL is the address of the feed document itself, if it is known.
+	URL string
+	// Updated is the date of the most recent release, zero if there are no
+	// entries.
+	Updated time.Time
+	Entries []Entry
+}
+
+// Entry holds a release of a project with its release note, if the release
+// has one.
+type Entry struct {
+	Project newreleases.Project
+	newreleases.Release
+	Note *newreleases.ReleaseNote
+}
+
+// ID returns a stable entry identifier based on the project ID and the
+// version.
+func (e *Entry) ID() string {
+	return idPrefix + "release/" + url.PathEscape(e.Project.ID) + "/" + url.PathEscape(e.Version)
+}
+
+// Title returns the project provider, name and the version, with prereleases
+// marked.
+func (e *Entry) Title() string {
+	title := string(e.Project.Provider) + "/" + e.Project.Name + " " + e.Version
+	if e.IsPrerelease {
+		title += " (prerelease)"
+	}
+	return title
+}
+
+// Link returns the release note URL, or the project URL if the release has no
+// note with one.
+func (e *Entry) Link() string {
+	if e.Note != nil && e.Note.URL != "" {
+		return e.Note.URL
+	}
+	if e.Project.URL != "" {
+		return e.Project.URL
+	}
+	u, _ := newreleases.ProjectURL(e.Project.Provider, e.Project.Name)
+	return u
+}
+
+// Categories returns entry categories, "prerelease" for prereleases and CVE
+// identifiers.
+func (e *Entry) Categories() (c []string) {
+	if e.IsPrerelease {
+		c = append(c, "prerelease")
+	}
+	return append(c, e.CVE...)
+}
+
+// Content returns the entry content as sanitized HTML, with the prerelease
+// mark, links to CVE identifiers and the release note.
+func (e *Entry) Content() string {
+	var b strings.Builder
+	if e.IsPrerelease {
+		b.WriteString("<p>Prerelease.</p>\n")
+	}
+	if len(e.CVE) > 0 {
+		b.WriteString("<p>Security fixes:")
+		for i, id := range e.CVE {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			fmt.Fprintf(&b, ` <a href="%s">%s</a>`, html.EscapeString(newreleases.CVEURL(id)), html.EscapeString(id))
+		}
+		b.WriteString("</p>\n")
+	}
+	if e.Note != nil {
+		if e.Note.Title != "" {
+			fmt.Fprintf(&b, "<h3>%s</h3>\n", html.EscapeString(e.Note.Title))
+		}
+		if h := render.Parse(e.Note).HTML(); h != "" {
+			b.WriteString(h)
+			b.WriteString("\n")
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Options holds optional parameters for Generate.
+type Options struct {
+	// Title is the feed title. If it is not set, the title is based on the
+	// tag name or the provider.
+	Title string
+	// TagID limits the feed to projects with the tag.
+	TagID string
+	// Provider limits the feed to projects of the provider.
+	Provider newreleases.Provider
+	// Link is the address of the website that the feed refers to,
+	// https://newreleases.io/ if it is not set.
+	Link string
+	// Since limits the feed to releases published on or after Since, if it is
+	// not zero.
+	Since time.Time
+	// Limit is the maximal number of entries, DefaultLimit if it is not set.
+	Limit int
+	// MaxPages limits the number of listed release pages for every project.
+	// Only the first page, with the most recent releases, is listed if it is
+	// zero.
+	MaxPages int
+}
+
+// Generate lists tracked projects, all of them or only with the tag or of the
+// provider, and returns a feed with their most recent releases. Release notes
+// are requested only for releases that have them and are in the feed.
+func Generate(ctx context.Context, client *newreleases.Client, o *Options) (f *Feed, err error) {
+	if o == nil {
+		o = new(Options)
+	}
+	limit := o.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	f = &Feed{
+		ID:    feedID(o),
+		Title: o.Title,
+		Link:  o.Link,
+	}
+	if f.Link == "" {
+		f.Link = "https://newreleases.io/"
+	}
+	if f.Title == "" {
+		if f.Title, err = title(ctx, client, o); err != nil {
+			return nil, err
+		}
+	}
+
+	err = client.Projects.Walk(ctx, newreleases.ProjectListOptions{
+		Provider: o.Provider,
+		TagID:    o.TagID,
+	}, func(p newreleases.Project) (stop bool, err error) {
+		f.Entries, err = appendEntries(ctx, client, f.Entries, p, o)
+		return false, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(f.Entries, func(i, j int) bool {
+		a, b := f.Entries[i], f.Entries[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.After(b.Date)
+		}
+		return a.ID() < b.ID()
+	})
+	if len(f.Entries) > limit {
+		f.Entries = f.Entries[:limit]
+	}
+	if len(f.Entries) > 0 {
+		f.Updated = f.Entries[0].Date
+	}
+
+	for i, e := range f.Entries {
+		if !e.HasNote {
+			continue
+		}
+		note, err := client.Releases.GetNoteByProjectID(ctx, e.Project.ID, e.Version)
+		if err != nil {
+			if errors.Is(err, newreleases.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("release note %s %s: %w", e.Project.Ref(), e.Version, err)
+		}
+		f.Entries[i].Note = note
+	}
+	return f, nil
+}
+
+func appendEntries(ctx context.Context, client *newreleases.Client, entries []Entry, p newreleases.Project, o *Options) ([]Entry, error) {
+	maxPages := o.MaxPages
+	if maxPages <= 0 {
+		maxPages = 1
+	}
+	err := client.Releases.WalkSince(ctx, newreleases.ProjectRefByID(p.ID), o.Since, maxPages, func(r newreleases.Release) (stop bool, err error) {
+		entries = append(entries, Entry{
+			Project: p,
+			Release: r,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func feedID(o *Options) string {
+	id := idPrefix + "releases"
+	if o.Provider != "" {
+		id += "/provider/" + url.PathEscape(string(o.Provider))
+	}
+	if o.TagID != "" {
+		id += "/tag/" + url.PathEscape(o.TagID)
+	}
+	return id
+}
+
+func title(ctx context.Context, client *newreleases.Client, o *Options) (string, error) {
+	title := "Releases of projects"
+	if o.Provider != "" {
+		title = "Releases of " + string(o.Provider) + " projects"
+	}
+	if o.TagID != "" {
+		tag, err := client.Tags.Get(ctx, o.TagID)
+		if err != nil {
+			return "", err
+		}
+		title += " tagged " + tag.Name
+	}
+	return title, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package feed_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/feed"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestGenerate(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	mux.HandleFunc("/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag"); got != "t1" {
+			t.Errorf("got tag %q, want %q", got, "t1")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintln(w, `{"projects": [
+			{"id": "app", "provider": "github", "name": "acme/app"},
+			{"id": "lib", "provider": "npm", "name": "lib", "url": "https://www.npmjs.com/package/lib"}
+		], "total_pages": 1}`)
+	})
+	testutil.Handle(mux, "/v1/tags/t1", `{"id": "t1", "name": "backend"}`)
+	testutil.Handle(mux, "/v1/projects/app/releases", `{"releases": [
+		{"version": "v2.0.0-rc.1", "date": "2023-06-01T00:00:00Z", "is_prerelease": true},
+		{"version": "v1.9.2", "date": "2023-05-01T00:00:00Z", "has_note": true, "cve": ["CVE-2023-0001"]},
+		{"version": "v1.9.1", "date": "2023-01-01T00:00:00Z", "has_note": true}
+	], "total_pages": 3}`)
+	testutil.Handle(mux, "/v1/projects/lib/releases", `{"releases": [
+		{"version": "3.1.0", "date": "2023-05-15T00:00:00Z", "has_note": true},
+		{"version": "3.0.0", "date": "2023-05-01T00:00:00Z"}
+	], "total_pages": 1}`)
+	testutil.Handle(mux, "/v1/projects/app/releases/v1.9.2/note", `{"title": "Security release", "message": "Fixes.", "url": "https://github.com/acme/app/releases/tag/v1.9.2"}`)
+	mux.HandleFunc("/v1/projects/lib/releases/3.1.0/note", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	got, err := feed.Generate(context.Background(), client, &feed.Options{
+		TagID: "t1",
+		Since: testutil.Date("2023-03-01"),
+		Limit: 3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := newreleases.Project{ID: "app", Provider: "github", Name: "acme/app"}
+	lib := newreleases.Project{ID: "lib", Provider: "npm", Name: "lib", URL: "https://www.npmjs.com/package/lib"}
+	testutil.AssertEqual(t, "", got, &feed.Feed{
+		ID:      "tag:newreleases.io,2019:releases/tag/t1",
+		Title:   "Releases of projects tagged backend",
+		Link:    "https://newreleases.io/",
+		Updated: testutil.Date("2023-06-01"),
+		Entries: []feed.Entry{
+			{Project: app, Release: newreleases.Release{Version: "v2.0.0-rc.1", Date: testutil.Date("2023-06-01"), IsPrerelease: true}},
+			{Project: lib, Release: newreleases.Release{Version: "3.1.0", Date: testutil.Date("2023-05-15"), HasNote: true}},
+			{Project: app, Release: newreleases.Release{Version: "v1.9.2", Date: testutil.Date("2023-05-01"), HasNote: true, CVE: []string{"CVE-2023-0001"}}, Note: &newreleases.ReleaseNote{
+				Title:   "Security release",
+				Message: "Fixes.",
+				URL:     "https://github.com/acme/app/releases/tag/v1.9.2",
+			}},
+		},
+	})
+}
+
+func TestGenerate_empty(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/projects", `{"projects": [], "total_pages": 0}`)
+
+	got, err := feed.Generate(context.Background(), client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "", got, &feed.Feed{
+		ID:    "tag:newreleases.io,2019:releases",
+		Title: "Releases of projects",
+		Link:  "https://newreleases.io/",
+	})
+}
+
+func TestEntry(t *testing.T) {
+	e := feed.Entry{
+		Project: newreleases.Project{ID: "app id", Provider: "github", Name: "acme/app"},
+		Release: newreleases.Release{
+			Version:      "v2.0.0/rc",
+			IsPrerelease: true,
+			CVE:          []string{"CVE-2023-0001", "CVE-2023-0002"},
+		},
+	}
+	testutil.AssertEqual(t, "id", e.ID(), "tag:newreleases.io,2019:release/app%20id/v2.0.0%2Frc")
+	testutil.AssertEqual(t, "title", e.Title(), "github/acme/app v2.0.0/rc (prerelease)")
+	testutil.AssertEqual(t, "link", e.Link(), "https://github.com/acme/app/releases")
+	testutil.AssertEqual(t, "categories", e.Categories(), []string{"prerelease", "CVE-2023-0001", "CVE-2023-0002"})
+	testutil.AssertEqual(t, "content", e.Content(), `<p>Prerelease.</p>
+<p>Security fixes: <a href="https://nvd.nist.gov/vuln/detail/CVE-2023-0001">CVE-2023-0001</a>, <a href="https://nvd.nist.gov/vuln/detail/CVE-2023-0002">CVE-2023-0002</a></p>`)
+
+	e = feed.Entry{
+		Project: newreleases.Project{ID: "app", Provider: "github", Name: "acme/app"},
+		Release: newreleases.Release{Version: "v1.0.0"},
+		Note: &newreleases.ReleaseNote{
+			Title:   "First <release>",
+			Message: "Hello <script>alert(1)</script>",
+			URL:     "https://github.com/acme/app/releases/tag/v1.0.0",
+		},
+	}
+	testutil.AssertEqual(t, "link", e.Link(), "https://github.com/acme/app/releases/tag/v1.0.0")
+	testutil.AssertEqual(t, "categories", e.Categories(), []string(nil))
+	testutil.AssertEqual(t, "content", e.Content(), `<h3>First &lt;release&gt;</h3>
+<p>Hello &lt;script&gt;alert(1)&lt;/script&gt;</p>`)
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+const generator = "NewReleases Go client"
+
+type atomFeed struct {
+	XMLName   xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Updated   string      `xml:"updated"`
+	Author    atomPerson  `xml:"author"`
+	Links     []atomLink  `xml:"link"`
+	Generator string      `xml:"generator"`
+	Entries   []atomEntry `xml:"entry"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Link       *atomLink      `xml:"link"`
+	Categories []atomCategory `xml:"category"`
+	Content    *atomContent   `xml:"content"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// WriteAtom writes the feed as an Atom 1.0 document.
+func (f *Feed) WriteAtom(w io.Writer) (err error) {
+	a := atomFeed{
+		ID:        f.ID,
+		Title:     f.Title,
+		Updated:   atomDate(f.Updated),
+		Author:    atomPerson{Name: "NewReleases"},
+		Links:     []atomLink{{Rel: "alternate", Href: f.Link}},
+		Generator: generator,
+	}
+	if f.URL != "" {
+		a.Links = append(a.Links, atomLink{Rel: "self", Type: "application/atom+xml", Href: f.URL})
+	}
+	for _, e := range f.Entries {
+		entry := atomEntry{
+			ID:        e.ID(),
+			Title:     e.Title(),
+			Published: atomDate(e.Date),
+			Updated:   atomDate(e.Date),
+		}
+		if link := e.Link(); link != "" {
+			entry.Link = &atomLink{Rel: "alternate", Href: link}
+		}
+		for _, c := range e.Categories() {
+			entry.Categories = append(entry.Categories, atomCategory{Term: c})
+		}
+		if content := e.Content(); content != "" {
+			entry.Content = &atomContent{Type: "html", Body: content}
+		}
+		a.Entries = append(a.Entries, entry)
+	}
+	return writeXML(w, a)
+}
+
+// atomDate formats the time as RFC 3339 date, using the Unix epoch for zero
+// time, as the date is required by the Atom specification.
+func atomDate(t time.Time) string {
+	if t.IsZero() {
+		t = time.Unix(0, 0)
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr,omitempty"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Self          *atomLink `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Generator     string    `xml:"generator"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link,omitempty"`
+	Description string   `xml:"description,omitempty"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// WriteRSS writes the feed as an RSS 2.0 document.
+func (f *Feed) WriteRSS(w io.Writer) (err error) {
+	r := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       f.Title,
+			Link:        f.Link,
+			Description: f.Title,
+			Generator:   generator,
+		},
+	}
+	if f.URL != "" {
+		r.Atom = "http://www.w3.org/2005/Atom"
+		r.Channel.Self = &atomLink{Rel: "self", Type: "application/rss+xml", Href: f.URL}
+	}
+	if !f.Updated.IsZero() {
+		r.Channel.LastBuildDate = f.Updated.UTC().Format(time.RFC1123Z)
+	}
+	for _, e := range f.Entries {
+		r.Channel.Items = append(r.Channel.Items, rssItem{
+			Title:       e.Title(),
+			Link:        e.Link(),
+			Description: e.Content(),
+			GUID:        rssGUID{Value: e.ID()},
+			PubDate:     e.Date.UTC().Format(time.RFC1123Z),
+			Categories:  e.Categories(),
+		})
+	}
+	return writeXML(w, r)
+}
+
+func writeXML(w io.Writer, v interface{}) (err error) {
+	if _, err = io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err = enc.Encode(v); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+type jsonFeed struct {
+	Version     string     `json:"version"`
+	Title       string     `json:"title"`
+	HomePageURL string     `json:"home_page_url,omitempty"`
+	FeedURL     string     `json:"feed_url,omitempty"`
+	Items       []jsonItem `json:"items"`
+}
+
+type jsonItem struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url,omitempty"`
+	Title         string   `json:"title"`
+	ContentHTML   string   `json:"content_html"`
+	DatePublished string   `json:"date_published"`
+	Tags          []string `json:"tags,omitempty"`
+}
+
+// WriteJSON writes the feed as a JSON Feed 1.1 document.
+func (f *Feed) WriteJSON(w io.Writer) (err error) {
+	j := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     f.URL,
+		Items:       make([]jsonItem, 0, len(f.Entries)),
+	}
+	for _, e := range f.Entries {
+		j.Items = append(j.Items, jsonItem{
+			ID:            e.ID(),
+			URL:           e.Link(),
+			Title:         e.Title(),
+			ContentHTML:   e.Content(),
+			DatePublished: e.Date.UTC().Format(time.RFC3339),
+			Tags:          e.Categories(),
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(j)
+}
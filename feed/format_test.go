@@ -0,0 +1,141 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package feed_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/feed"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestFeed_WriteAtom(t *testing.T) {
+	var b strings.Builder
+	if err := testFeed().WriteAtom(&b); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "", b.String(), `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>tag:newreleases.io,2019:releases</id>
+  <title>Releases &amp; more</title>
+  <updated>2023-06-01T00:00:00Z</updated>
+  <author>
+    <name>NewReleases</name>
+  </author>
+  <link rel="alternate" href="https://newreleases.io/"></link>
+  <link rel="self" type="application/atom+xml" href="https://example.com/feed"></link>
+  <generator>NewReleases Go client</generator>
+  <entry>
+    <id>tag:newreleases.io,2019:release/app/v2.0.0-rc.1</id>
+    <title>github/acme/app v2.0.0-rc.1 (prerelease)</title>
+    <published>2023-06-01T00:00:00Z</published>
+    <updated>2023-06-01T00:00:00Z</updated>
+    <link rel="alternate" href="https://github.com/acme/app/releases/tag/v2.0.0-rc.1"></link>
+    <category term="prerelease"></category>
+    <category term="CVE-2023-0001"></category>
+    <content type="html">&lt;p&gt;Prerelease.&lt;/p&gt;&#xA;&lt;p&gt;Security fixes: &lt;a href=&#34;https://nvd.nist.gov/vuln/detail/CVE-2023-0001&#34;&gt;CVE-2023-0001&lt;/a&gt;&lt;/p&gt;&#xA;Fixes &lt;b&gt;bugs&lt;/b&gt;.</content>
+  </entry>
+</feed>
+`)
+}
+
+func TestFeed_WriteAtom_empty(t *testing.T) {
+	var b strings.Builder
+	if err := (&feed.Feed{ID: "id", Title: "Releases", Link: "https://newreleases.io/"}).WriteAtom(&b); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "", b.String(), `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>id</id>
+  <title>Releases</title>
+  <updated>1970-01-01T00:00:00Z</updated>
+  <author>
+    <name>NewReleases</name>
+  </author>
+  <link rel="alternate" href="https://newreleases.io/"></link>
+  <generator>NewReleases Go client</generator>
+</feed>
+`)
+}
+
+func TestFeed_WriteRSS(t *testing.T) {
+	var b strings.Builder
+	if err := testFeed().WriteRSS(&b); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "", b.String(), `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">
+  <channel>
+    <title>Releases &amp; more</title>
+    <link>https://newreleases.io/</link>
+    <description>Releases &amp; more</description>
+    <atom:link rel="self" type="application/rss+xml" href="https://example.com/feed"></atom:link>
+    <lastBuildDate>Thu, 01 Jun 2023 00:00:00 +0000</lastBuildDate>
+    <generator>NewReleases Go client</generator>
+    <item>
+      <title>github/acme/app v2.0.0-rc.1 (prerelease)</title>
+      <link>https://github.com/acme/app/releases/tag/v2.0.0-rc.1</link>
+      <description>&lt;p&gt;Prerelease.&lt;/p&gt;&#xA;&lt;p&gt;Security fixes: &lt;a href=&#34;https://nvd.nist.gov/vuln/detail/CVE-2023-0001&#34;&gt;CVE-2023-0001&lt;/a&gt;&lt;/p&gt;&#xA;Fixes &lt;b&gt;bugs&lt;/b&gt;.</description>
+      <guid isPermaLink="false">tag:newreleases.io,2019:release/app/v2.0.0-rc.1</guid>
+      <pubDate>Thu, 01 Jun 2023 00:00:00 +0000</pubDate>
+      <category>prerelease</category>
+      <category>CVE-2023-0001</category>
+    </item>
+  </channel>
+</rss>
+`)
+}
+
+func TestFeed_WriteJSON(t *testing.T) {
+	var b strings.Builder
+	if err := testFeed().WriteJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "", b.String(), `{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "Releases & more",
+  "home_page_url": "https://newreleases.io/",
+  "feed_url": "https://example.com/feed",
+  "items": [
+    {
+      "id": "tag:newreleases.io,2019:release/app/v2.0.0-rc.1",
+      "url": "https://github.com/acme/app/releases/tag/v2.0.0-rc.1",
+      "title": "github/acme/app v2.0.0-rc.1 (prerelease)",
+      "content_html": "<p>Prerelease.</p>\n<p>Security fixes: <a href=\"https://nvd.nist.gov/vuln/detail/CVE-2023-0001\">CVE-2023-0001</a></p>\nFixes <b>bugs</b>.",
+      "date_published": "2023-06-01T00:00:00Z",
+      "tags": [
+        "prerelease",
+        "CVE-2023-0001"
+      ]
+    }
+  ]
+}
+`)
+}
+
+func testFeed() *feed.Feed {
+	date := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	return &feed.Feed{
+		ID:      "tag:newreleases.io,2019:releases",
+		Title:   "Releases & more",
+		Link:    "https://newreleases.io/",
+		URL:     "https://example.com/feed",
+		Updated: date,
+		Entries: []feed.Entry{
+			{
+				Project: newreleases.Project{ID: "app", Provider: "github", Name: "acme/app"},
+				Release: newreleases.Release{Version: "v2.0.0-rc.1", Date: date, IsPrerelease: true, CVE: []string{"CVE-2023-0001"}},
+				Note: &newreleases.ReleaseNote{
+					Message: "Fixes <b>bugs</b>.",
+					URL:     "https://github.com/acme/app/releases/tag/v2.0.0-rc.1",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package feed
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/internal/httpcache"
+)
+
+// DefaultMaxAge is the duration for which generated feeds are cached if it is
+// not set in HandlerOptions.
+const DefaultMaxAge = 5 * time.Minute
+
+// HandlerOptions holds optional parameters for NewHandler.
+type HandlerOptions struct {
+	// Options are used to generate feeds. The tag and provider query
+	// parameters replace TagID and Provider if they are present in the
+	// request.
+	Options
+	// MaxAge is the duration for which a generated feed is cached and which
+	// is sent in the Cache-Control header, DefaultMaxAge if it is not set.
+	MaxAge time.Duration
+	// MaxEntries limits the number of cached feeds, one for every tag and
+	// provider combination, 100 if it is not set.
+	MaxEntries int
+	// BaseURL is the public address of the handler, which feeds link to with
+	// the request query. Feeds have no self link if it is not set, as the
+	// host of a request is set by the client.
+	BaseURL *url.URL
+	// Timeout limits the duration of generating a feed, which is not
+	// canceled with the request as it is shared by concurrent requests, one
+	// minute if it is not set.
+	Timeout time.Duration
+}
+
+type handler struct {
+	client *newreleases.Client
+	o      HandlerOptions
+	cache  *httpcache.Cache
+}
+
+// NewHandler returns an HTTP handler that serves feeds with the most recent
+// releases. The format is selected by the "format" query parameter, "atom",
+// which is the default, "rss" or "json". Projects are selected by the "tag"
+// query parameter with the tag ID and the "provider" query parameter. Other
+// query parameters are ignored.
+//
+// Generated feeds are cached, with a single feed generated for concurrent
+// requests, and responses have Cache-Control, ETag and Last-Modified headers,
+// so that conditional requests are answered with the Not Modified status.
+func NewHandler(client *newreleases.Client, o *HandlerOptions) http.Handler {
+	if o == nil {
+		o = new(HandlerOptions)
+	}
+	h := &handler{
+		client: client,
+		o:      *o,
+	}
+	if h.o.MaxAge <= 0 {
+		h.o.MaxAge = DefaultMaxAge
+	}
+	h.cache = httpcache.New(h.o.MaxAge, h.o.MaxEntries, h.o.Timeout)
+	return h
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !httpcache.AllowMethod(w, r) {
+		return
+	}
+
+	q := r.URL.Query()
+	var write func(f *Feed, w io.Writer) error
+	var contentType string
+	switch q.Get("format") {
+	case "", "atom":
+		write, contentType = (*Feed).WriteAtom, "application/atom+xml; charset=utf-8"
+	case "rss":
+		write, contentType = (*Feed).WriteRSS, "application/rss+xml; charset=utf-8"
+	case "json":
+		write, contentType = (*Feed).WriteJSON, "application/feed+json; charset=utf-8"
+	default:
+		http.Error(w, "unknown feed format", http.StatusBadRequest)
+		return
+	}
+
+	o := h.o.Options
+	if values, ok := q["tag"]; ok {
+		tagIDs, err := httpcache.IDs("tag", values, 1)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		o.TagID = ""
+		if len(tagIDs) > 0 {
+			o.TagID = tagIDs[0]
+		}
+	}
+	if _, ok := q["provider"]; ok {
+		provider, err := httpcache.Provider(q.Get("provider"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		o.Provider = provider
+	}
+
+	v, err := h.cache.Get(r.Context(), o.TagID+"|"+string(o.Provider), func(ctx context.Context) (interface{}, error) {
+		return Generate(ctx, h.client, &o)
+	})
+	if err != nil {
+		httpcache.Error(w, err)
+		return
+	}
+
+	// The feed is copied to set the address of the requested document.
+	feed := *v.(*Feed)
+	if h.o.BaseURL != nil {
+		u := *h.o.BaseURL
+		u.RawQuery = r.URL.RawQuery
+		feed.URL = u.String()
+	}
+	var b bytes.Buffer
+	if err := write(&feed, &b); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	h.cache.Serve(w, r, contentType, feed.Updated, b.Bytes())
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package feed_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"newreleases.io/newreleases/feed"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestNewHandler(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	var projectRequests int32
+	mux.HandleFunc("/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&projectRequests, 1)
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintln(w, `{"projects": [{"id": "app", "provider": "github", "name": "acme/app"}], "total_pages": 1}`)
+	})
+	testutil.Handle(mux, "/v1/projects/app/releases", `{"releases": [
+		{"version": "v1.0.0", "date": "2023-06-01T00:00:00Z"}
+	], "total_pages": 1}`)
+	mux.HandleFunc("/v1/tags/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	h := feed.NewHandler(client, &feed.HandlerOptions{
+		MaxAge:  time.Hour,
+		BaseURL: &url.URL{Scheme: "https", Host: "feeds.example.com", Path: "/feed"},
+	})
+
+	for _, tc := range []struct {
+		query       string
+		contentType string
+		prefix      string
+	}{
+		{query: "", contentType: "application/atom+xml; charset=utf-8", prefix: `<?xml version="1.0" encoding="UTF-8"?>` + "\n<feed"},
+		{query: "?format=rss", contentType: "application/rss+xml; charset=utf-8", prefix: `<?xml version="1.0" encoding="UTF-8"?>` + "\n<rss"},
+		{query: "?format=json", contentType: "application/feed+json; charset=utf-8", prefix: `{`},
+	} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://example.com/feed"+tc.query, nil))
+
+		testutil.AssertEqual(t, "status"+tc.query, w.Code, http.StatusOK)
+		testutil.AssertEqual(t, "content type"+tc.query, w.Header().Get("Content-Type"), tc.contentType)
+		testutil.AssertEqual(t, "cache control"+tc.query, w.Header().Get("Cache-Control"), "public, max-age=3600")
+		testutil.AssertEqual(t, "last modified"+tc.query, w.Header().Get("Last-Modified"), "Thu, 01 Jun 2023 00:00:00 GMT")
+		if !strings.HasPrefix(w.Body.String(), tc.prefix) {
+			t.Errorf("%s: got body %q, want prefix %q", tc.query, w.Body.String(), tc.prefix)
+		}
+		if !strings.Contains(w.Body.String(), "https://feeds.example.com/feed"+tc.query) {
+			t.Errorf("%s: feed url not found in %q", tc.query, w.Body.String())
+		}
+
+		etag := w.Header().Get("ETag")
+		if etag == "" {
+			t.Fatalf("%s: no etag", tc.query)
+		}
+		r := httptest.NewRequest(http.MethodGet, "http://example.com/feed"+tc.query, nil)
+		r.Header.Set("If-None-Match", etag)
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		testutil.AssertEqual(t, "not modified status"+tc.query, w.Code, http.StatusNotModified)
+		testutil.AssertEqual(t, "not modified body"+tc.query, w.Body.String(), "")
+	}
+	testutil.AssertEqual(t, "project requests", atomic.LoadInt32(&projectRequests), int32(1))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://example.com/feed?format=csv", nil))
+	testutil.AssertEqual(t, "unknown format status", w.Code, http.StatusBadRequest)
+
+	for _, query := range []string{"?tag=a&tag=b", "?tag=a%7Cb", "?provider=githab"} {
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://example.com/feed"+query, nil))
+		testutil.AssertEqual(t, "invalid query status "+query, w.Code, http.StatusBadRequest)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "http://example.com/feed", nil))
+	testutil.AssertEqual(t, "method status", w.Code, http.StatusMethodNotAllowed)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://example.com/feed?tag=missing", nil))
+	testutil.AssertEqual(t, "missing tag status", w.Code, http.StatusNotFound)
+}
+
+func TestNewHandler_defaults(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	var mu sync.Mutex
+	var requests []string
+	handleProjects := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.URL.Path+" "+r.URL.Query().Get("tag"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintln(w, `{"projects": [{"id": "app", "provider": "github", "name": "acme/app"}], "total_pages": 1}`)
+	}
+	mux.HandleFunc("/v1/projects", handleProjects)
+	mux.HandleFunc("/v1/projects/github", handleProjects)
+	mux.HandleFunc("/v1/projects/npm", handleProjects)
+	testutil.Handle(mux, "/v1/projects/app/releases", `{"releases": [
+		{"version": "v1.0.0", "date": "2023-06-01T00:00:00Z"}
+	], "total_pages": 1}`)
+
+	h := feed.NewHandler(client, &feed.HandlerOptions{
+		Options: feed.Options{Title: "Releases", TagID: "dev", Provider: "github"},
+	})
+
+	for _, query := range []string{"", "?format=rss", "?tag=ops", "?provider=npm", "?provider="} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://example.com/feed"+query, nil))
+		testutil.AssertEqual(t, "status "+query, w.Code, http.StatusOK)
+		if strings.Contains(w.Body.String(), "example.com") {
+			t.Errorf("%s: got self link without base url in %q", query, w.Body.String())
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	testutil.AssertEqual(t, "requests", requests, []string{
+		"/v1/projects/github dev",
+		"/v1/projects/github ops",
+		"/v1/projects/npm dev",
+		"/v1/projects dev",
+	})
+}
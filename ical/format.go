@@ -0,0 +1,131 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"newreleases.io/newreleases"
+)
+
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405Z"
+	// maxLineLength is the maximal length of a content line in octets,
+	// without the line break.
+	maxLineLength = 75
+)
+
+// Write writes the calendar as an iCalendar document with an all-day event
+// for every release, on the release date in UTC.
+func (c *Calendar) Write(w io.Writer) (err error) {
+	bw := bufio.NewWriter(w)
+	l := lineWriter{w: bw}
+	l.line("BEGIN", "VCALENDAR")
+	l.line("VERSION", "2.0")
+	l.line("PRODID", "-//NewReleases//NewReleases Go client//EN")
+	l.line("CALSCALE", "GREGORIAN")
+	l.line("METHOD", "PUBLISH")
+	l.line("X-WR-CALNAME", escape(c.Name))
+	for _, e := range c.Events {
+		date := e.Date.UTC()
+		l.line("BEGIN", "VEVENT")
+		l.line("UID", e.UID())
+		// The release date is used as the time stamp, so that the document
+		// does not change if releases do not change.
+		l.line("DTSTAMP", date.Format(dateTimeFormat))
+		l.line("DTSTART;VALUE=DATE", date.Format(dateFormat))
+		l.line("DTEND;VALUE=DATE", date.AddDate(0, 0, 1).Format(dateFormat))
+		l.line("SUMMARY", escape(e.Summary()))
+		if d := e.description(); d != "" {
+			l.line("DESCRIPTION", escape(d))
+		}
+		if u := e.url(); u != "" {
+			l.line("URL", u)
+		}
+		if categories := e.categories(); len(categories) > 0 {
+			for i := range categories {
+				categories[i] = escape(categories[i])
+			}
+			l.line("CATEGORIES", strings.Join(categories, ","))
+		}
+		l.line("TRANSP", "TRANSPARENT")
+		l.line("END", "VEVENT")
+	}
+	l.line("END", "VCALENDAR")
+	if l.err != nil {
+		return l.err
+	}
+	return bw.Flush()
+}
+
+func (e *Event) description() string {
+	var lines []string
+	if e.IsPrerelease {
+		lines = append(lines, "Prerelease.")
+	}
+	if len(e.CVE) > 0 {
+		lines = append(lines, "Security fixes:")
+		for _, id := range e.CVE {
+			lines = append(lines, id+" "+newreleases.CVEURL(id))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (e *Event) url() string {
+	if e.Project.URL != "" {
+		return e.Project.URL
+	}
+	u, _ := newreleases.ProjectURL(e.Project.Provider, e.Project.Name)
+	return u
+}
+
+func (e *Event) categories() (c []string) {
+	if e.IsPrerelease {
+		c = append(c, "prerelease")
+	}
+	return append(c, e.CVE...)
+}
+
+// lineWriter writes content lines folded to maxLineLength octets and
+// terminated with CRLF, keeping the first error.
+type lineWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (l *lineWriter) line(name, value string) {
+	if l.err != nil {
+		return
+	}
+	s := name + ":" + value
+	limit := maxLineLength
+	for len(s) > limit {
+		i := limit
+		// Multi-octet characters are not split.
+		for i > 0 && !utf8.RuneStart(s[i]) {
+			i--
+		}
+		if _, l.err = l.w.WriteString(s[:i] + "\r\n "); l.err != nil {
+			return
+		}
+		s = s[i:]
+		// Continuation lines start with a space.
+		limit = maxLineLength - 1
+	}
+	_, l.err = l.w.WriteString(s + "\r\n")
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// escape escapes a TEXT property value.
+func escape(s string) string {
+	return textEscaper.Replace(s)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ical_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/ical"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestCalendar_Write(t *testing.T) {
+	c := &ical.Calendar{
+		Name: "Releases; backend, frontend",
+		Events: []ical.Event{
+			{
+				Project: newreleases.Project{ID: "app", Provider: "github", Name: "acme/app"},
+				Release: newreleases.Release{Version: "v2.0.0-rc.1", Date: time.Date(2023, 5, 31, 23, 30, 0, 0, time.FixedZone("", -2*3600)), IsPrerelease: true, CVE: []string{"CVE-2023-0001"}},
+			},
+			{
+				Project: newreleases.Project{ID: "lib", Provider: "npm", Name: "@acme/ünïcödé-library-with-a-very-long-name-xxñññññññ", URL: "https://www.npmjs.com/package/lib"},
+				Release: newreleases.Release{Version: "3.1.0", Date: time.Date(2023, 6, 2, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+	var b strings.Builder
+	if err := c.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.ReplaceAll(`BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//NewReleases//NewReleases Go client//EN
+CALSCALE:GREGORIAN
+METHOD:PUBLISH
+X-WR-CALNAME:Releases\; backend\, frontend
+BEGIN:VEVENT
+UID:release-app-v2.0.0-rc.1@newreleases.io
+DTSTAMP:20230601T013000Z
+DTSTART;VALUE=DATE:20230601
+DTEND;VALUE=DATE:20230602
+SUMMARY:github/acme/app v2.0.0-rc.1 (prerelease) (security)
+DESCRIPTION:Prerelease.\nSecurity fixes:\nCVE-2023-0001 https://nvd.nist.go
+ v/vuln/detail/CVE-2023-0001
+URL:https://github.com/acme/app/releases
+CATEGORIES:prerelease,CVE-2023-0001
+TRANSP:TRANSPARENT
+END:VEVENT
+BEGIN:VEVENT
+UID:release-lib-3.1.0@newreleases.io
+DTSTAMP:20230602T120000Z
+DTSTART;VALUE=DATE:20230602
+DTEND;VALUE=DATE:20230603
+SUMMARY:npm/@acme/ünïcödé-library-with-a-very-long-name-xxññññññ
+ ñ 3.1.0
+URL:https://www.npmjs.com/package/lib
+TRANSP:TRANSPARENT
+END:VEVENT
+END:VCALENDAR
+`, "\n", "\r\n")
+	testutil.AssertEqual(t, "", b.String(), want)
+
+	for _, line := range strings.Split(b.String(), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line %q is longer than 75 octets", line)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ical
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/internal/httpcache"
+)
+
+// Defaults for HandlerOptions.
+const (
+	DefaultWindow = 90 * 24 * time.Hour
+	DefaultMaxAge = 15 * time.Minute
+)
+
+// Limits of the number of projects and tags selected by a request.
+const (
+	MaxProjects = 100
+	MaxTags     = 20
+)
+
+// HandlerOptions holds optional parameters for NewHandler.
+type HandlerOptions struct {
+	// Options are used to generate calendars. Projects, tags and the
+	// provider from the request query replace Projects, TagIDs and Provider,
+	// and Since and Until are set from the rolling window.
+	Options
+	// Window is the duration before the request time from which releases are
+	// included, DefaultWindow if it is not set.
+	Window time.Duration
+	// MaxAge is the duration for which a generated calendar is cached and
+	// which is sent in the Cache-Control header, DefaultMaxAge if it is not
+	// set.
+	MaxAge time.Duration
+	// MaxEntries limits the number of cached calendars, one for every
+	// selection of projects, 100 if it is not set.
+	MaxEntries int
+	// Timeout is the longest time for which a calendar is generated, one
+	// minute if it is not set.
+	Timeout time.Duration
+}
+
+type handler struct {
+	client *newreleases.Client
+	o      HandlerOptions
+	cache  *httpcache.Cache
+}
+
+// NewHandler returns an HTTP handler that serves a calendar with releases
+// published within the rolling window before the request. Projects are
+// selected by the "project" query parameters with up to MaxProjects project
+// IDs, the "tag" query parameters with up to MaxTags tag IDs and the
+// "provider" query parameter. The order and repetition of IDs do not change
+// the selection, and other query parameters are ignored.
+//
+// Generated calendars are cached, with a single calendar generated for
+// concurrent requests, and responses have Cache-Control, ETag and
+// Last-Modified headers, so that conditional requests are answered with the
+// Not Modified status.
+func NewHandler(client *newreleases.Client, o *HandlerOptions) http.Handler {
+	if o == nil {
+		o = new(HandlerOptions)
+	}
+	h := &handler{
+		client: client,
+		o:      *o,
+	}
+	if h.o.Window <= 0 {
+		h.o.Window = DefaultWindow
+	}
+	if h.o.MaxAge <= 0 {
+		h.o.MaxAge = DefaultMaxAge
+	}
+	h.cache = httpcache.New(h.o.MaxAge, h.o.MaxEntries, h.o.Timeout)
+	return h
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !httpcache.AllowMethod(w, r) {
+		return
+	}
+
+	q := r.URL.Query()
+	o := h.o.Options
+	projectIDs, err := httpcache.IDs("project", q["project"], MaxProjects)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	o.Projects = nil
+	for _, id := range projectIDs {
+		o.Projects = append(o.Projects, newreleases.ProjectRefByID(id))
+	}
+	if o.TagIDs, err = httpcache.IDs("tag", q["tag"], MaxTags); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if o.Provider, err = httpcache.Provider(q.Get("provider")); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	key := strings.Join([]string{
+		strings.Join(projectIDs, ","),
+		strings.Join(o.TagIDs, ","),
+		string(o.Provider),
+	}, "|")
+	v, err := h.cache.Get(r.Context(), key, func(ctx context.Context) (interface{}, error) {
+		o.Since = time.Now().Add(-h.o.Window)
+		o.Until = time.Time{}
+		return Generate(ctx, h.client, &o)
+	})
+	if err != nil {
+		httpcache.Error(w, err)
+		return
+	}
+	c := v.(*Calendar)
+
+	var b bytes.Buffer
+	if err := c.Write(&b); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	var modified time.Time
+	for _, e := range c.Events {
+		if e.Date.After(modified) {
+			modified = e.Date
+		}
+	}
+	h.cache.Serve(w, r, "text/calendar; charset=utf-8", modified, b.Bytes())
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ical_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"newreleases.io/newreleases/ical"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestNewHandler(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	now := time.Now().UTC()
+	recent := now.Add(-48 * time.Hour).Truncate(time.Second)
+	old := now.Add(-30 * 24 * time.Hour)
+
+	var projectRequests int32
+	mux.HandleFunc("/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&projectRequests, 1)
+		if got := r.URL.Query().Get("tag"); got != "t1" {
+			t.Errorf("got tag %q, want %q", got, "t1")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintln(w, `{"projects": [{"id": "app", "provider": "github", "name": "acme/app"}], "total_pages": 1}`)
+	})
+	var releaseRequests int32
+	mux.HandleFunc("/v1/projects/app/releases", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&releaseRequests, 1)
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if r.URL.Query().Get("page") != "" {
+			fmt.Fprintf(w, `{"releases": [{"version": "v0.9.0", "date": %q}], "total_pages": 5}`, old.Format(time.RFC3339))
+			return
+		}
+		fmt.Fprintf(w, `{"releases": [
+			{"version": "v1.1.0", "date": %q},
+			{"version": "v1.0.0", "date": %q}
+		], "total_pages": 5}`, recent.Format(time.RFC3339), old.Format(time.RFC3339))
+	})
+	mux.HandleFunc("/v1/projects/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	h := ical.NewHandler(client, &ical.HandlerOptions{
+		Window: 7 * 24 * time.Hour,
+		MaxAge: time.Hour,
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://example.com/releases.ics?tag=t1", nil))
+
+	testutil.AssertEqual(t, "status", w.Code, http.StatusOK)
+	testutil.AssertEqual(t, "content type", w.Header().Get("Content-Type"), "text/calendar; charset=utf-8")
+	testutil.AssertEqual(t, "cache control", w.Header().Get("Cache-Control"), "public, max-age=3600")
+	testutil.AssertEqual(t, "last modified", w.Header().Get("Last-Modified"), recent.Format(http.TimeFormat))
+	body := w.Body.String()
+	if !strings.Contains(body, "UID:release-app-v1.1.0@newreleases.io\r\n") {
+		t.Errorf("recent release not found in %q", body)
+	}
+	if strings.Contains(body, "v1.0.0") {
+		t.Errorf("release before the window found in %q", body)
+	}
+	testutil.AssertEqual(t, "release requests", atomic.LoadInt32(&releaseRequests), int32(2))
+
+	r := httptest.NewRequest(http.MethodGet, "http://example.com/releases.ics?tag=t1", nil)
+	r.Header.Set("If-None-Match", w.Header().Get("ETag"))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	testutil.AssertEqual(t, "not modified status", w.Code, http.StatusNotModified)
+	testutil.AssertEqual(t, "project requests", atomic.LoadInt32(&projectRequests), int32(1))
+
+	// Repeated IDs and other parameters select the same cached calendar.
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://example.com/releases.ics?tag=t1&tag=t1&x=1", nil))
+	testutil.AssertEqual(t, "repeated tag status", w.Code, http.StatusOK)
+	testutil.AssertEqual(t, "repeated tag project requests", atomic.LoadInt32(&projectRequests), int32(1))
+
+	tooMany := make([]string, ical.MaxProjects+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("project=p%d", i)
+	}
+	for _, query := range []string{
+		"?tag=t1,t2",
+		"?provider=githab",
+		"?" + strings.Join(tooMany, "&"),
+	} {
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://example.com/releases.ics"+query, nil))
+		testutil.AssertEqual(t, "invalid query status", w.Code, http.StatusBadRequest)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://example.com/releases.ics?project=missing", nil))
+	testutil.AssertEqual(t, "missing project status", w.Code, http.StatusNotFound)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "http://example.com/releases.ics", nil))
+	testutil.AssertEqual(t, "method status", w.Code, http.StatusMethodNotAllowed)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ical generates iCalendar (RFC 5545) documents with release dates of
+// projects tracked on NewReleases, and serves them over HTTP as calendars
+// that can be subscribed to.
+package ical // import "newreleases.io/newreleases/ical"
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"time"
+
+	"newreleases.io/newreleases"
+)
+
+// Calendar holds release events ordered by date.
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// Event holds a release of a project.
+type Event struct {
+	Project newreleases.Project
+	newreleases.Release
+}
+
+// UID returns a stable event identifier based on the project ID and the
+// version, so that calendar clients update existing events instead of adding
+// duplicates.
+func (e *Event) UID() string {
+	return "release-" + url.PathEscape(e.Project.ID) + "-" + url.PathEscape(e.Version) + "@newreleases.io"
+}
+
+// Summary returns the project provider, name and the version, with
+// prereleases and releases with CVE identifiers marked.
+func (e *Event) Summary() string {
+	s := string(e.Project.Provider) + "/" + e.Project.Name + " " + e.Version
+	if e.IsPrerelease {
+		s += " (prerelease)"
+	}
+	if len(e.CVE) > 0 {
+		s += " (security)"
+	}
+	return s
+}
+
+// Options holds optional parameters for Generate.
+type Options struct {
+	// Name is the calendar name, "Releases" if it is not set.
+	Name string
+	// Projects and TagIDs select projects, either referenced directly or with
+	// any of the tags. All tracked projects are selected if both are empty.
+	Projects []newreleases.ProjectRef
+	TagIDs   []string
+	// Provider limits tagged or all tracked projects to projects of the
+	// provider.
+	Provider newreleases.Provider
+	// Since and Until limit the calendar to releases published on or after
+	// Since and before Until, if they are not zero.
+	Since time.Time
+	Until time.Time
+	// MaxPages limits the number of listed release pages for every project.
+	// Pages are listed until a page with all releases before Since if it is
+	// zero.
+	MaxPages int
+}
+
+// Generate returns a calendar with releases of the selected projects.
+func Generate(ctx context.Context, client *newreleases.Client, o *Options) (c *Calendar, err error) {
+	if o == nil {
+		o = new(Options)
+	}
+	c = &Calendar{Name: o.Name}
+	if c.Name == "" {
+		c.Name = "Releases"
+	}
+
+	projects, err := selectProjects(ctx, client, o)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		if c.Events, err = appendEvents(ctx, client, c.Events, p, o); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(c.Events, func(i, j int) bool {
+		a, b := c.Events[i], c.Events[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		return a.UID() < b.UID()
+	})
+	return c, nil
+}
+
+// selectProjects returns directly referenced projects and projects with any
+// of the tags, without duplicates, or all projects if there are no references
+// and tags.
+func selectProjects(ctx context.Context, client *newreleases.Client, o *Options) (projects []newreleases.Project, err error) {
+	seen := make(map[string]struct{})
+	add := func(p newreleases.Project) {
+		if _, ok := seen[p.ID]; ok {
+			return
+		}
+		seen[p.ID] = struct{}{}
+		projects = append(projects, p)
+	}
+
+	for _, ref := range o.Projects {
+		p, err := client.Projects.Get(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		add(*p)
+	}
+
+	tagIDs := o.TagIDs
+	if len(o.Projects) == 0 && len(tagIDs) == 0 {
+		// An empty tag lists all projects.
+		tagIDs = []string{""}
+	}
+	for _, tagID := range tagIDs {
+		err := client.Projects.Walk(ctx, newreleases.ProjectListOptions{
+			Provider: o.Provider,
+			TagID:    tagID,
+		}, func(p newreleases.Project) (stop bool, err error) {
+			add(p)
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return projects, nil
+}
+
+func appendEvents(ctx context.Context, client *newreleases.Client, events []Event, p newreleases.Project, o *Options) ([]Event, error) {
+	err := client.Releases.WalkSince(ctx, newreleases.ProjectRefByID(p.ID), o.Since, o.MaxPages, func(r newreleases.Release) (stop bool, err error) {
+		if !o.Until.IsZero() && !r.Date.Before(o.Until) {
+			return false, nil
+		}
+		events = append(events, Event{
+			Project: p,
+			Release: r,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
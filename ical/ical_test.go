@@ -0,0 +1,121 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ical_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/ical"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestGenerate(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/projects/github/acme/app", `{"id": "app", "provider": "github", "name": "acme/app"}`)
+	mux.HandleFunc("/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		switch tag := r.URL.Query().Get("tag"); tag {
+		case "t1":
+			fmt.Fprintln(w, `{"projects": [
+				{"id": "app", "provider": "github", "name": "acme/app"},
+				{"id": "lib", "provider": "npm", "name": "lib"}
+			], "total_pages": 1}`)
+		case "t2":
+			fmt.Fprintln(w, `{"projects": [
+				{"id": "lib", "provider": "npm", "name": "lib"}
+			], "total_pages": 1}`)
+		default:
+			t.Errorf("unexpected tag %q", tag)
+		}
+	})
+	mux.HandleFunc("/v1/projects/app/releases", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprintln(w, `{"releases": [
+				{"version": "v2.0.0", "date": "2023-07-01T00:00:00Z"},
+				{"version": "v2.0.0-rc.1", "date": "2023-06-01T00:00:00Z", "is_prerelease": true}
+			], "total_pages": 4}`)
+		case "2":
+			fmt.Fprintln(w, `{"releases": [
+				{"version": "v1.9.2", "date": "2023-05-01T00:00:00Z", "cve": ["CVE-2023-0001"]},
+				{"version": "v1.9.1", "date": "2023-01-01T00:00:00Z"}
+			], "total_pages": 4}`)
+		case "3":
+			fmt.Fprintln(w, `{"releases": [
+				{"version": "v1.9.0", "date": "2022-12-01T00:00:00Z"}
+			], "total_pages": 4}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+	testutil.Handle(mux, "/v1/projects/lib/releases", `{"releases": [
+		{"version": "3.1.0", "date": "2023-05-15T00:00:00Z"}
+	], "total_pages": 1}`)
+
+	got, err := ical.Generate(context.Background(), client, &ical.Options{
+		Projects: []newreleases.ProjectRef{newreleases.ProjectRefByName(newreleases.ProviderGitHub, "acme/app")},
+		TagIDs:   []string{"t1", "t2"},
+		Since:    testutil.Date("2023-03-01"),
+		Until:    testutil.Date("2023-07-01"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := newreleases.Project{ID: "app", Provider: "github", Name: "acme/app"}
+	lib := newreleases.Project{ID: "lib", Provider: "npm", Name: "lib"}
+	testutil.AssertEqual(t, "", got, &ical.Calendar{
+		Name: "Releases",
+		Events: []ical.Event{
+			{Project: app, Release: newreleases.Release{Version: "v1.9.2", Date: testutil.Date("2023-05-01"), CVE: []string{"CVE-2023-0001"}}},
+			{Project: lib, Release: newreleases.Release{Version: "3.1.0", Date: testutil.Date("2023-05-15")}},
+			{Project: app, Release: newreleases.Release{Version: "v2.0.0-rc.1", Date: testutil.Date("2023-06-01"), IsPrerelease: true}},
+		},
+	})
+}
+
+func TestGenerate_all(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	mux.HandleFunc("/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag"); got != "" {
+			t.Errorf("got tag %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintln(w, `{"projects": [{"id": "lib", "provider": "npm", "name": "lib"}], "total_pages": 1}`)
+	})
+	testutil.Handle(mux, "/v1/projects/lib/releases", `{"releases": [
+		{"version": "3.1.0", "date": "2023-05-15T00:00:00Z"}
+	], "total_pages": 1}`)
+
+	got, err := ical.Generate(context.Background(), client, &ical.Options{Name: "All"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "", got, &ical.Calendar{
+		Name: "All",
+		Events: []ical.Event{
+			{Project: newreleases.Project{ID: "lib", Provider: "npm", Name: "lib"}, Release: newreleases.Release{Version: "3.1.0", Date: testutil.Date("2023-05-15")}},
+		},
+	})
+}
+
+func TestEvent(t *testing.T) {
+	e := ical.Event{
+		Project: newreleases.Project{ID: "app id", Provider: "github", Name: "acme/app"},
+		Release: newreleases.Release{Version: "v2.0.0/rc", IsPrerelease: true, CVE: []string{"CVE-2023-0001"}},
+	}
+	testutil.AssertEqual(t, "uid", e.UID(), "release-app%20id-v2.0.0%2Frc@newreleases.io")
+	testutil.AssertEqual(t, "summary", e.Summary(), "github/acme/app v2.0.0/rc (prerelease) (security)")
+}
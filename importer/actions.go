@@ -0,0 +1,104 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"newreleases.io/newreleases"
+)
+
+// ParseAction maps a GitHub Actions uses reference, such as actions/checkout@v4
+// or github/codeql-action/init@v2, to the github provider project of the
+// action repository. The pinned ref is set as the reference version and
+// recorded in its note. Local actions (./path) and Docker image actions
+// (docker://image) are reported with ok set to false.
+func ParseAction(uses string) (ref Ref, ok bool) {
+	uses = strings.TrimSpace(uses)
+	if strings.HasPrefix(uses, "./") || strings.HasPrefix(uses, "docker://") || strings.Contains(uses, "${{") {
+		return Ref{}, false
+	}
+	i := strings.LastIndex(uses, "@")
+	if i < 0 {
+		return Ref{}, false
+	}
+	path, version := uses[:i], uses[i+1:]
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" || version == "" {
+		return Ref{}, false
+	}
+	return Ref{
+		Provider: newreleases.ProviderGitHub,
+		Name:     parts[0] + "/" + parts[1],
+		Version:  version,
+		Note:     "pinned to " + version,
+	}, true
+}
+
+// ParseWorkflow returns references for all actions and reusable workflows used
+// in a GitHub Actions workflow file.
+func ParseWorkflow(r io.Reader) (refs []Ref, err error) {
+	documents, err := readYAMLDocuments(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, lines := range documents {
+		for _, uses := range yamlValues(lines, "jobs/*/uses", "jobs/*/steps/uses") {
+			if ref, ok := ParseAction(uses); ok {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return Unique(refs), nil
+}
+
+// ScanWorkflows parses all workflow files in the .github/workflows directory of
+// a repository and returns a unified list of references for actions that they
+// use.
+func ScanWorkflows(repository string) (refs []Ref, err error) {
+	var filenames []string
+	for _, pattern := range []string{"*.yml", "*.yaml"} {
+		m, err := filepath.Glob(filepath.Join(repository, ".github", "workflows", pattern))
+		if err != nil {
+			return nil, err
+		}
+		filenames = append(filenames, m...)
+	}
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		r, err := parseWorkflowFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		refs = append(refs, r...)
+	}
+	return Unique(refs), nil
+}
+
+func parseWorkflowFile(filename string) (refs []Ref, err error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseWorkflow(f)
+}
+
+func isWorkflowFile(filename string) bool {
+	ext := strings.ToLower(filepath.Ext(filename))
+	if ext != ".yml" && ext != ".yaml" {
+		return false
+	}
+	dir := filepath.Dir(filename)
+	return filepath.Base(dir) == "workflows" && filepath.Base(filepath.Dir(dir)) == ".github"
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases/importer"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestParseAction(t *testing.T) {
+	for _, tc := range []struct {
+		uses string
+		want importer.Ref
+		ok   bool
+	}{
+		{
+			uses: "actions/checkout@v4",
+			want: importer.Ref{Provider: "github", Name: "actions/checkout", Version: "v4", Note: "pinned to v4"},
+			ok:   true,
+		},
+		{
+			uses: "github/codeql-action/init@v2",
+			want: importer.Ref{Provider: "github", Name: "github/codeql-action", Version: "v2", Note: "pinned to v2"},
+			ok:   true,
+		},
+		{
+			uses: "octo-org/workflows/.github/workflows/build.yml@8f4b7f84864484a7bf31766abe9204da3cbe65b3",
+			want: importer.Ref{
+				Provider: "github",
+				Name:     "octo-org/workflows",
+				Version:  "8f4b7f84864484a7bf31766abe9204da3cbe65b3",
+				Note:     "pinned to 8f4b7f84864484a7bf31766abe9204da3cbe65b3",
+			},
+			ok: true,
+		},
+		{uses: "./.github/actions/setup"},
+		{uses: "docker://alpine:3.18"},
+		{uses: "actions/checkout"},
+		{uses: "${{ matrix.action }}@v1"},
+	} {
+		t.Run(tc.uses, func(t *testing.T) {
+			got, ok := importer.ParseAction(tc.uses)
+			testutil.AssertEqual(t, "ok", ok, tc.ok)
+			testutil.AssertEqual(t, "ref", got, tc.want)
+		})
+	}
+}
+
+func TestParseWorkflow_nested(t *testing.T) {
+	got, err := importer.ParseWorkflow(strings.NewReader(`on:
+  workflow_call:
+    inputs:
+      uses:
+        type: string
+        default: ignored/input@v1
+jobs:
+  build:
+    steps:
+      - run: |
+          uses: ignored/run@v1
+      - uses: actions/checkout@v4
+        with:
+          uses: ignored/with@v1
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "github", Name: "actions/checkout", Version: "v4", Note: "pinned to v4"},
+	})
+}
+
+func TestScanWorkflows(t *testing.T) {
+	dir := t.TempDir()
+	workflows := filepath.Join(dir, ".github", "workflows")
+	if err := os.MkdirAll(workflows, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(workflows, "ci.yml"), `name: CI
+on: [push]
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - name: Set up Go
+        uses: "actions/setup-go@v5"
+        with:
+          go-version: "1.21"
+      - uses: ./.github/actions/lint
+      - uses: docker://golangci/golangci-lint:v1.54
+      - uses: github/codeql-action/init@v2 # security
+      - uses: github/codeql-action/analyze@v2
+  reuse:
+    uses: octo-org/workflows/.github/workflows/deploy.yml@v1
+`)
+	writeFile(t, filepath.Join(workflows, "release.yaml"), `on: release
+jobs:
+  release:
+    steps:
+      - uses: actions/checkout@v3
+      - uses: goreleaser/goreleaser-action@v5
+`)
+	writeFile(t, filepath.Join(workflows, "README.md"), "uses: ignored/action@v1\n")
+
+	got, err := importer.ScanWorkflows(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "github", Name: "actions/checkout", Version: "v4", Note: "pinned to v4"},
+		{Provider: "github", Name: "actions/setup-go", Version: "v5", Note: "pinned to v5"},
+		{Provider: "github", Name: "github/codeql-action", Version: "v2", Note: "pinned to v2"},
+		{Provider: "github", Name: "octo-org/workflows", Version: "v1", Note: "pinned to v1"},
+		{Provider: "github", Name: "goreleaser/goreleaser-action", Version: "v5", Note: "pinned to v5"},
+	})
+
+	got, err = importer.ParseFile(filepath.Join(workflows, "release.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "parse file", got, []importer.Ref{
+		{Provider: "github", Name: "actions/checkout", Version: "v3", Note: "pinned to v3"},
+		{Provider: "github", Name: "goreleaser/goreleaser-action", Version: "v5", Note: "pinned to v5"},
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer
+
+import (
+	"io"
+	"strings"
+
+	"newreleases.io/newreleases"
+)
+
+// ParseCargoTOML returns references for all dependencies declared in a Cargo
+// manifest, including development, build, target specific and workspace
+// dependencies. Renamed dependencies are resolved to their package names,
+// dependencies from GitHub repositories are mapped to the github provider and
+// local path dependencies are skipped.
+func ParseCargoTOML(r io.Reader) (refs []Ref, err error) {
+	entries, err := parseTOML(r)
+	if err != nil {
+		return nil, err
+	}
+
+	type dependency struct {
+		name  string
+		attrs map[string]interface{}
+	}
+	var deps []*dependency
+	index := make(map[string]*dependency)
+
+	for _, e := range entries {
+		path := append(append([]string(nil), e.Table...), e.Key...)
+		i := cargoDependenciesIndex(path)
+		if i < 0 || i+1 >= len(path) {
+			continue
+		}
+		name := path[i+1]
+		k := strings.Join(path[:i+2], "\x00")
+		d, ok := index[k]
+		if !ok {
+			d = &dependency{name: name, attrs: make(map[string]interface{})}
+			index[k] = d
+			deps = append(deps, d)
+		}
+		switch attr := path[i+2:]; len(attr) {
+		case 0:
+			switch v := e.Value.(type) {
+			case string:
+				d.attrs["version"] = v
+			case map[string]interface{}:
+				for k, v := range v {
+					d.attrs[k] = v
+				}
+			}
+		default:
+			d.attrs[attr[0]] = e.Value
+		}
+	}
+
+	for _, d := range deps {
+		if ref, ok := cargoDependencyRef(d.name, d.attrs); ok {
+			refs = append(refs, ref)
+		}
+	}
+	return Unique(refs), nil
+}
+
+// cargoDependenciesIndex returns the index of the dependencies table name in
+// the key path or -1 if the path is not in a dependencies table.
+func cargoDependenciesIndex(path []string) int {
+	for i, p := range path {
+		switch p {
+		case "dependencies", "dev-dependencies", "build-dependencies":
+			return i
+		}
+	}
+	return -1
+}
+
+func cargoDependencyRef(name string, attrs map[string]interface{}) (ref Ref, ok bool) {
+	if pkg, ok := attrs["package"].(string); ok && pkg != "" {
+		name = pkg
+	}
+	version, _ := attrs["version"].(string)
+	if git, ok := attrs["git"].(string); ok {
+		repo, ok := githubRepository(git)
+		if !ok {
+			return Ref{}, false
+		}
+		for _, k := range []string{"tag", "rev", "branch"} {
+			if v, ok := attrs[k].(string); ok {
+				version = v
+				break
+			}
+		}
+		return Ref{Provider: newreleases.ProviderGitHub, Name: repo, Version: version}, true
+	}
+	if _, ok := attrs["path"]; ok && version == "" {
+		return Ref{}, false
+	}
+	if _, ok := attrs["registry"]; ok {
+		// Alternative registries are not tracked.
+		return Ref{}, false
+	}
+	return Ref{Provider: newreleases.ProviderCargo, Name: name, Version: version}, true
+}
+
+// ParseCargoLock returns references for all packages from crates.io and GitHub
+// repositories that are locked in a Cargo.lock file. Workspace members are
+// skipped.
+func ParseCargoLock(r io.Reader) (refs []Ref, err error) {
+	entries, err := parseTOML(r)
+	if err != nil {
+		return nil, err
+	}
+
+	type pkg struct {
+		name, version, source string
+	}
+	var packages []*pkg
+	for _, e := range entries {
+		if len(e.Table) != 1 || e.Table[0] != "package" || len(e.Key) != 1 {
+			continue
+		}
+		for len(packages) <= e.Index {
+			packages = append(packages, new(pkg))
+		}
+		v, _ := e.Value.(string)
+		switch e.Key[0] {
+		case "name":
+			packages[e.Index].name = v
+		case "version":
+			packages[e.Index].version = v
+		case "source":
+			packages[e.Index].source = v
+		}
+	}
+
+	for _, p := range packages {
+		switch {
+		case p.name == "":
+		case strings.HasPrefix(p.source, "registry+https://github.com/rust-lang/crates.io-index"),
+			strings.HasPrefix(p.source, "sparse+https://index.crates.io/"):
+			refs = append(refs, Ref{Provider: newreleases.ProviderCargo, Name: p.name, Version: p.version})
+		case strings.HasPrefix(p.source, "git+"):
+			if repo, ok := githubRepository(strings.TrimPrefix(p.source, "git+")); ok {
+				refs = append(refs, Ref{Provider: newreleases.ProviderGitHub, Name: repo, Version: p.version})
+			}
+		}
+	}
+	return Unique(refs), nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer_test
+
+import (
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases/importer"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestParseCargoTOML(t *testing.T) {
+	got, err := importer.ParseCargoTOML(strings.NewReader(`
+[package]
+name = "example"
+version = "0.1.0"
+description = """
+A multi-line
+description."""
+
+[dependencies]
+serde = { version = "1.0", features = ["derive"] }
+tokio = { version = "1", features = [
+    "rt-multi-thread", # comment
+    "macros",
+] }
+rand = "0.8.5" # comment
+http02 = { package = "http", version = "0.2" }
+local = { path = "../local" }
+hyper = { git = "https://github.com/hyperium/hyper", tag = "v1.0.0" }
+private = { version = "1", registry = "internal" }
+log.version = "0.4"
+
+[dependencies.regex]
+version = "1.9"
+default-features = false
+
+[dev-dependencies]
+criterion = "0.5"
+
+[build-dependencies]
+cc = "1.0"
+
+[target.'cfg(windows)'.dependencies]
+winapi = "0.3"
+
+[workspace.dependencies]
+anyhow = "1"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "cargo", Name: "serde", Version: "1.0"},
+		{Provider: "cargo", Name: "tokio", Version: "1"},
+		{Provider: "cargo", Name: "rand", Version: "0.8.5"},
+		{Provider: "cargo", Name: "http", Version: "0.2"},
+		{Provider: "github", Name: "hyperium/hyper", Version: "v1.0.0"},
+		{Provider: "cargo", Name: "log", Version: "0.4"},
+		{Provider: "cargo", Name: "regex", Version: "1.9"},
+		{Provider: "cargo", Name: "criterion", Version: "0.5"},
+		{Provider: "cargo", Name: "cc", Version: "1.0"},
+		{Provider: "cargo", Name: "winapi", Version: "0.3"},
+		{Provider: "cargo", Name: "anyhow", Version: "1"},
+	})
+}
+
+func TestParseCargoTOML_invalid(t *testing.T) {
+	_, err := importer.ParseCargoTOML(strings.NewReader("[dependencies]\nserde 1.0\n"))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestParseCargoLock(t *testing.T) {
+	got, err := importer.ParseCargoLock(strings.NewReader(`
+# This file is automatically @generated by Cargo.
+# It is not intended for manual editing.
+version = 3
+
+[[package]]
+name = "example"
+version = "0.1.0"
+dependencies = [
+ "serde",
+]
+
+[[package]]
+name = "hyper"
+version = "1.0.0"
+source = "git+https://github.com/hyperium/hyper?tag=v1.0.0#0123456789abcdef"
+
+[[package]]
+name = "serde"
+version = "1.0.188"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "cf9e0fcba69a370eed61bcf2b728575f726b50b55cba78064753d708ddc7549e"
+
+[[package]]
+name = "log"
+version = "0.4.20"
+source = "sparse+https://index.crates.io/"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "github", Name: "hyperium/hyper", Version: "1.0.0"},
+		{Provider: "cargo", Name: "serde", Version: "1.0.188"},
+		{Provider: "cargo", Name: "log", Version: "0.4.20"},
+	})
+}
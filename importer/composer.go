@@ -0,0 +1,49 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+
+	"newreleases.io/newreleases"
+)
+
+// ParseComposerJSON returns references for all packages required in a
+// Composer composer.json file, including development requirements. Platform
+// requirements, such as php and extensions, are skipped.
+func ParseComposerJSON(r io.Reader) (refs []Ref, err error) {
+	var manifest struct {
+		Require    map[string]string `json:"require"`
+		RequireDev map[string]string `json:"require-dev"`
+	}
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return nil, err
+	}
+
+	for _, require := range []map[string]string{manifest.Require, manifest.RequireDev} {
+		names := make([]string, 0, len(require))
+		for name := range require {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if !strings.Contains(name, "/") {
+				// Platform packages like php, ext-json, lib-curl and
+				// composer-plugin-api are not vendor/package names.
+				continue
+			}
+			refs = append(refs, Ref{
+				Provider: newreleases.ProviderPackagist,
+				Name:     strings.ToLower(name),
+				Version:  require[name],
+			})
+		}
+	}
+	return Unique(refs), nil
+}
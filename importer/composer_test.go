@@ -0,0 +1,39 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer_test
+
+import (
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases/importer"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestParseComposerJSON(t *testing.T) {
+	got, err := importer.ParseComposerJSON(strings.NewReader(`{
+		"name": "acme/app",
+		"require": {
+			"php": ">=8.1",
+			"ext-json": "*",
+			"symfony/console": "^6.3",
+			"Monolog/Monolog": "^3.0"
+		},
+		"require-dev": {
+			"phpunit/phpunit": "^10.0",
+			"symfony/console": "^6.3"
+		}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "packagist", Name: "monolog/monolog", Version: "^3.0"},
+		{Provider: "packagist", Name: "symfony/console", Version: "^6.3"},
+		{Provider: "packagist", Name: "phpunit/phpunit", Version: "^10.0"},
+	})
+}
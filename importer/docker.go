@@ -0,0 +1,275 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"newreleases.io/newreleases"
+)
+
+// ParseImage maps a container image reference, such as nginx:1.25,
+// quay.io/prometheus/prometheus:v2.47.0 or ghcr.io/owner/image@sha256:...,
+// to the registry provider and repository name. The tag, or the digest if the
+// tag is not specified, is set as the reference version and recorded in its
+// note. Images from registries that are not supported by NewReleases are
+// reported with ok set to false.
+func ParseImage(image string) (ref Ref, ok bool) {
+	image = strings.TrimSpace(image)
+	if image == "" || strings.Contains(image, "$") {
+		return Ref{}, false
+	}
+
+	var digest, tag string
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, digest = image[:i], image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i >= 0 && !strings.Contains(image[i:], "/") {
+		image, tag = image[:i], image[i+1:]
+	}
+
+	registry := ""
+	if i := strings.Index(image, "/"); i >= 0 {
+		if first := image[:i]; strings.ContainsAny(first, ".:") || first == "localhost" {
+			registry, image = first, image[i+1:]
+		}
+	}
+	if image == "" {
+		return Ref{}, false
+	}
+
+	switch registry {
+	case "", "docker.io", "index.docker.io", "registry-1.docker.io":
+		ref.Provider = newreleases.ProviderDockerHub
+		if !strings.Contains(image, "/") {
+			image = "library/" + image
+		}
+		image = strings.ToLower(image)
+	case "quay.io":
+		ref.Provider = newreleases.ProviderQuay
+	case "ghcr.io":
+		ref.Provider = newreleases.ProviderGHCR
+		image = strings.ToLower(image)
+	default:
+		return Ref{}, false
+	}
+	ref.Name = image
+
+	switch {
+	case tag != "":
+		ref.Version = tag
+		ref.Note = "tag " + tag
+	case digest != "":
+		ref.Version = digest
+		ref.Note = "digest " + digest
+	}
+	return ref, true
+}
+
+// ParseDockerfile returns references for all images used in FROM instructions
+// of a Dockerfile. Variables in image names are substituted with default
+// values of ARG instructions declared before the first FROM, and references to
+// earlier build stages and the scratch image are skipped.
+func ParseDockerfile(r io.Reader) (refs []Ref, err error) {
+	args := make(map[string]string)
+	stages := make(map[string]struct{})
+	seenFrom := false
+
+	instructions, err := readDockerfileInstructions(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, fields := range instructions {
+		switch strings.ToUpper(fields[0]) {
+		case "ARG":
+			if seenFrom {
+				continue
+			}
+			for _, arg := range fields[1:] {
+				name, value := arg, ""
+				if i := strings.Index(arg, "="); i >= 0 {
+					name, value = arg[:i], unquoteShell(arg[i+1:])
+				}
+				args[name] = value
+			}
+		case "FROM":
+			seenFrom = true
+			var image string
+			for i := 1; i < len(fields); i++ {
+				if strings.HasPrefix(fields[i], "--") {
+					continue
+				}
+				image = fields[i]
+				if i+2 < len(fields) && strings.EqualFold(fields[i+1], "AS") {
+					stages[strings.ToLower(fields[i+2])] = struct{}{}
+				}
+				break
+			}
+			image, ok := expandVariables(image, func(name string) (string, bool) {
+				v, ok := args[name]
+				return v, ok && v != ""
+			})
+			if !ok || image == "" || strings.EqualFold(image, "scratch") {
+				continue
+			}
+			if _, ok := stages[strings.ToLower(image)]; ok {
+				continue
+			}
+			if ref, ok := ParseImage(image); ok {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return Unique(refs), nil
+}
+
+// readDockerfileInstructions returns fields of all instructions with line
+// continuations joined and comments removed.
+func readDockerfileInstructions(r io.Reader) (instructions [][]string, err error) {
+	var current string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasSuffix(line, "\\") {
+			current += strings.TrimSuffix(line, "\\") + " "
+			continue
+		}
+		current += line
+		if fields := strings.Fields(current); len(fields) > 0 {
+			instructions = append(instructions, fields)
+		}
+		current = ""
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if fields := strings.Fields(current); len(fields) > 0 {
+		instructions = append(instructions, fields)
+	}
+	return instructions, nil
+}
+
+// expandVariables substitutes $NAME, ${NAME}, ${NAME:-default} and
+// ${NAME-default} references using the lookup function. It returns false if
+// any of the variables could not be resolved.
+func expandVariables(s string, lookup func(name string) (value string, ok bool)) (expanded string, ok bool) {
+	var b strings.Builder
+	ok = true
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == '$' {
+			b.WriteByte('$')
+			i++
+			continue
+		}
+		var name, def string
+		var hasDefault bool
+		if i+1 < len(s) && s[i+1] == '{' {
+			end := strings.Index(s[i:], "}")
+			if end < 0 {
+				return "", false
+			}
+			expr := s[i+2 : i+end]
+			i += end
+			name = expr
+			for _, sep := range []string{":-", "-"} {
+				if j := strings.Index(expr, sep); j >= 0 {
+					name, def, hasDefault = expr[:j], expr[j+len(sep):], true
+					break
+				}
+			}
+		} else {
+			j := i + 1
+			for j < len(s) && (s[j] == '_' || s[j] >= 'a' && s[j] <= 'z' || s[j] >= 'A' && s[j] <= 'Z' || s[j] >= '0' && s[j] <= '9') {
+				j++
+			}
+			name = s[i+1 : j]
+			i = j - 1
+		}
+		if v, found := lookup(name); found {
+			b.WriteString(v)
+		} else if hasDefault {
+			b.WriteString(def)
+		} else {
+			ok = false
+		}
+	}
+	return b.String(), ok
+}
+
+func unquoteShell(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// ParseCompose returns references for all images of services in a Docker
+// Compose file. Environment variables in image names are substituted with
+// their default values.
+func ParseCompose(r io.Reader) (refs []Ref, err error) {
+	documents, err := readYAMLDocuments(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, lines := range documents {
+		for _, image := range yamlValues(lines, "services/*/image") {
+			image, ok := expandVariables(image, func(string) (string, bool) {
+				return "", false
+			})
+			if !ok {
+				continue
+			}
+			if ref, ok := ParseImage(image); ok {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return Unique(refs), nil
+}
+
+// kubernetesWorkloadKinds are Kubernetes resource kinds that define
+// containers.
+var kubernetesWorkloadKinds = map[string]struct{}{
+	"Deployment":  {},
+	"StatefulSet": {},
+	"DaemonSet":   {},
+	"ReplicaSet":  {},
+	"Job":         {},
+	"CronJob":     {},
+	"Pod":         {},
+}
+
+// ParseKubernetes returns references for images of all containers and init
+// containers defined in Kubernetes workload resources, such as Deployment,
+// StatefulSet and CronJob, from a multi-document YAML manifest. Other
+// resources are skipped.
+func ParseKubernetes(r io.Reader) (refs []Ref, err error) {
+	documents, err := readYAMLDocuments(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, lines := range documents {
+		if _, ok := kubernetesWorkloadKinds[yamlTopLevelValue(lines, "kind")]; !ok {
+			continue
+		}
+		for _, image := range yamlValues(lines, "**/containers/image", "**/initContainers/image") {
+			if ref, ok := ParseImage(image); ok {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return Unique(refs), nil
+}
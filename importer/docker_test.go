@@ -0,0 +1,260 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases/importer"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestParseImage(t *testing.T) {
+	for _, tc := range []struct {
+		image string
+		want  importer.Ref
+		ok    bool
+	}{
+		{
+			image: "nginx",
+			want:  importer.Ref{Provider: "dockerhub", Name: "library/nginx"},
+			ok:    true,
+		},
+		{
+			image: "nginx:1.25-alpine",
+			want:  importer.Ref{Provider: "dockerhub", Name: "library/nginx", Version: "1.25-alpine", Note: "tag 1.25-alpine"},
+			ok:    true,
+		},
+		{
+			image: "docker.io/grafana/grafana:10.1.0",
+			want:  importer.Ref{Provider: "dockerhub", Name: "grafana/grafana", Version: "10.1.0", Note: "tag 10.1.0"},
+			ok:    true,
+		},
+		{
+			image: "quay.io/prometheus/prometheus:v2.47.0",
+			want:  importer.Ref{Provider: "quay", Name: "prometheus/prometheus", Version: "v2.47.0", Note: "tag v2.47.0"},
+			ok:    true,
+		},
+		{
+			image: "ghcr.io/Owner/Image@sha256:abcdef",
+			want:  importer.Ref{Provider: "ghcr", Name: "owner/image", Version: "sha256:abcdef", Note: "digest sha256:abcdef"},
+			ok:    true,
+		},
+		{
+			image: "redis:7.2@sha256:abcdef",
+			want:  importer.Ref{Provider: "dockerhub", Name: "library/redis", Version: "7.2", Note: "tag 7.2"},
+			ok:    true,
+		},
+		{
+			image: "localhost:5000/app:1.0",
+		},
+		{
+			image: "gcr.io/distroless/static:nonroot",
+		},
+		{
+			image: "${IMAGE}",
+		},
+	} {
+		t.Run(tc.image, func(t *testing.T) {
+			got, ok := importer.ParseImage(tc.image)
+			testutil.AssertEqual(t, "ok", ok, tc.ok)
+			testutil.AssertEqual(t, "ref", got, tc.want)
+		})
+	}
+}
+
+func TestParseDockerfile(t *testing.T) {
+	got, err := importer.ParseDockerfile(strings.NewReader(`# syntax=docker/dockerfile:1
+ARG GO_VERSION=1.21
+ARG ALPINE_VERSION="3.18"
+ARG REGISTRY
+
+FROM --platform=$BUILDPLATFORM golang:${GO_VERSION}-alpine AS build
+ARG GO_VERSION=1.20
+RUN go build \
+    -o /app .
+
+FROM build AS test
+RUN go test ./...
+
+FROM ${REGISTRY}/tools:latest AS tools
+
+FROM alpine:$ALPINE_VERSION
+COPY --from=build /app /app
+
+from scratch
+COPY --from=build /app /app
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "dockerhub", Name: "library/golang", Version: "1.21-alpine", Note: "tag 1.21-alpine"},
+		{Provider: "dockerhub", Name: "library/alpine", Version: "3.18", Note: "tag 3.18"},
+	})
+}
+
+func TestParseCompose(t *testing.T) {
+	got, err := importer.ParseCompose(strings.NewReader(`version: "3.9"
+services:
+  web:
+    build: .
+    image: example/web
+  db:
+    image: "postgres:15.4" # database
+    environment:
+      POSTGRES_PASSWORD: secret
+  cache:
+    image: 'redis:${REDIS_TAG:-7.2}'
+  proxy:
+    image: ${PROXY_IMAGE}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "dockerhub", Name: "example/web"},
+		{Provider: "dockerhub", Name: "library/postgres", Version: "15.4", Note: "tag 15.4"},
+		{Provider: "dockerhub", Name: "library/redis", Version: "7.2", Note: "tag 7.2"},
+	})
+}
+
+func TestParseCompose_nested(t *testing.T) {
+	got, err := importer.ParseCompose(strings.NewReader(`x-defaults:
+  image: ignored/defaults:1.0
+services:
+  web:
+    labels:
+      image: ignored/label:1.0
+    command: |
+      image: ignored/command:1.0
+    image: example/web:2.0
+  worker:
+    deploy:
+      resources:
+        image: ignored/resources:1.0
+volumes:
+  data:
+    image: ignored/volume:1.0
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "dockerhub", Name: "example/web", Version: "2.0", Note: "tag 2.0"},
+	})
+}
+
+func TestParseKubernetes(t *testing.T) {
+	got, err := importer.ParseKubernetes(strings.NewReader(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  image: ignored:1.0
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  template:
+    spec:
+      initContainers:
+        - name: migrate
+          image: example/migrate:2.0
+      containers:
+        - name: web
+          image: nginx:1.25
+          ports:
+            - containerPort: 80
+---
+apiVersion: apps/v1
+kind: StatefulSet
+spec:
+  template:
+    spec:
+      containers:
+        - image: "quay.io/coreos/etcd:v3.5.9"
+          name: etcd
+---
+apiVersion: batch/v1
+kind: CronJob
+spec:
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: backup
+            image: ghcr.io/example/backup:1.4.0
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "dockerhub", Name: "example/migrate", Version: "2.0", Note: "tag 2.0"},
+		{Provider: "dockerhub", Name: "library/nginx", Version: "1.25", Note: "tag 1.25"},
+		{Provider: "quay", Name: "coreos/etcd", Version: "v3.5.9", Note: "tag v3.5.9"},
+		{Provider: "ghcr", Name: "example/backup", Version: "1.4.0", Note: "tag 1.4.0"},
+	})
+}
+
+func TestParseKubernetes_nested(t *testing.T) {
+	got, err := importer.ParseKubernetes(strings.NewReader(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    image: ignored/annotation:1.0
+spec:
+  template:
+    metadata:
+      labels:
+        image: ignored-label
+    spec:
+      containers:
+      - name: web
+        image: nginx:1.25
+        env:
+        - name: SIDECAR
+          image: ignored/env:1.0
+        args:
+        - --image
+      volumes:
+      - name: data
+        image:
+          reference: ignored/volume:1.0
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "dockerhub", Name: "library/nginx", Version: "1.25", Note: "tag 1.25"},
+	})
+}
+
+func TestParseFile_docker(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "Dockerfile.prod"), "FROM nginx:1.25\n")
+	writeFile(t, filepath.Join(dir, "docker-compose.override.yml"), "services:\n  db:\n    image: postgres:15\n")
+
+	got, err := importer.ParseFiles(filepath.Join(dir, "Dockerfile.prod"), filepath.Join(dir, "docker-compose.override.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "dockerhub", Name: "library/nginx", Version: "1.25", Note: "tag 1.25"},
+		{Provider: "dockerhub", Name: "library/postgres", Version: "15", Note: "tag 15"},
+	})
+}
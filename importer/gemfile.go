@@ -0,0 +1,135 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"newreleases.io/newreleases"
+)
+
+// ParseGemfileLock returns references for all direct dependencies listed in a
+// Bundler Gemfile.lock with their locked versions. Gems from RubyGems are
+// mapped to the gems provider, gems from GitHub repositories to the github
+// provider and gems from local paths are skipped.
+func ParseGemfileLock(r io.Reader) (refs []Ref, err error) {
+	type source struct {
+		kind     string
+		remote   string
+		revision string
+		ref      string
+	}
+	type spec struct {
+		version string
+		source  *source
+	}
+
+	var (
+		section      string
+		current      *source
+		inSpecs      bool
+		specs        = make(map[string]spec)
+		dependencies []string
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \r")
+		if line == "" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		text := strings.TrimSpace(line)
+
+		if indent == 0 {
+			section = text
+			inSpecs = false
+			current = nil
+			switch section {
+			case "GIT", "GEM", "PATH":
+				current = &source{kind: section}
+			}
+			continue
+		}
+
+		switch {
+		case current != nil && indent == 2:
+			inSpecs = text == "specs:"
+			switch key, value := splitGemfileOption(text); key {
+			case "remote":
+				current.remote = value
+			case "revision":
+				current.revision = value
+			case "tag", "branch", "ref":
+				if current.ref == "" || key == "tag" {
+					current.ref = value
+				}
+			}
+		case current != nil && inSpecs && indent == 4:
+			name, version := splitGemfileSpec(text)
+			if _, ok := specs[name]; !ok {
+				specs[name] = spec{version: version, source: current}
+			}
+		case section == "DEPENDENCIES" && indent == 2:
+			name, _ := splitGemfileSpec(strings.TrimSuffix(strings.Fields(text)[0], "!"))
+			dependencies = append(dependencies, name)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, name := range dependencies {
+		s, ok := specs[name]
+		if !ok {
+			refs = append(refs, Ref{Provider: newreleases.ProviderGems, Name: name})
+			continue
+		}
+		switch s.source.kind {
+		case "GEM":
+			refs = append(refs, Ref{Provider: newreleases.ProviderGems, Name: name, Version: s.version})
+		case "GIT":
+			repo, ok := githubRepository(s.source.remote)
+			if !ok {
+				continue
+			}
+			version := s.source.ref
+			if version == "" {
+				version = s.source.revision
+			}
+			refs = append(refs, Ref{Provider: newreleases.ProviderGitHub, Name: repo, Version: version})
+		}
+	}
+	return Unique(refs), nil
+}
+
+// gemPlatformSuffix matches the platform of a platform specific gem, like
+// x86_64-linux in 1.13.8-x86_64-linux.
+var gemPlatformSuffix = regexp.MustCompile(`-(x86|x64|i[3-6]86|arm|aarch64|universal|ppc|s390|wasm|java|jruby|mswin|mingw)[A-Za-z0-9_.-]*$`)
+
+// splitGemfileSpec splits a "name (version)" line into name and version at
+// the version separator, removing the platform suffix from the version.
+func splitGemfileSpec(s string) (name, version string) {
+	i := strings.Index(s, " (")
+	if i < 0 {
+		return s, ""
+	}
+	name = s[:i]
+	version = strings.TrimSuffix(s[i+2:], ")")
+	version = gemPlatformSuffix.ReplaceAllString(version, "")
+	return name, version
+}
+
+func splitGemfileOption(s string) (key, value string) {
+	i := strings.Index(s, ": ")
+	if i < 0 {
+		return "", ""
+	}
+	return s[:i], s[i+2:]
+}
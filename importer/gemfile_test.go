@@ -0,0 +1,94 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer_test
+
+import (
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases/importer"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestParseGemfileLock(t *testing.T) {
+	got, err := importer.ParseGemfileLock(strings.NewReader(`GIT
+  remote: https://github.com/rails/rails.git
+  revision: 3b21d35a1a0a5a10d1d47a5f44f0c6d1b4e3e5cd
+  branch: main
+  specs:
+    rails (7.1.0.alpha)
+      actioncable (= 7.1.0.alpha)
+
+PATH
+  remote: engines/billing
+  specs:
+    billing (0.1.0)
+
+GEM
+  remote: https://rubygems.org/
+  specs:
+    actioncable (7.0.4)
+      nio4r (~> 2.0)
+    nio4r (2.5.8)
+    nokogiri (1.13.8-x86_64-linux)
+      racc (~> 1.4)
+    puma (5.6.5)
+      nio4r (~> 2.0)
+    racc (1.6.0)
+
+PLATFORMS
+  x86_64-linux
+
+DEPENDENCIES
+  billing!
+  nokogiri
+  puma (~> 5.0)
+  rails!
+  unknown
+
+BUNDLED WITH
+   2.3.7
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "gems", Name: "nokogiri", Version: "1.13.8"},
+		{Provider: "gems", Name: "puma", Version: "5.6.5"},
+		{Provider: "github", Name: "rails/rails", Version: "main"},
+		{Provider: "gems", Name: "unknown"},
+	})
+}
+
+func TestParseGemfileLock_hyphens(t *testing.T) {
+	got, err := importer.ParseGemfileLock(strings.NewReader(`GEM
+  remote: https://rubygems.org/
+  specs:
+    ffi (1.15.5-x64-mingw-ucrt)
+    google-protobuf (3.24.4-arm64-darwin)
+    rspec-rails (6.0.3)
+      rspec-core (~> 3.12)
+    rspec-core (3.12.2)
+    sass-embedded (1.69.0-rc1)
+
+DEPENDENCIES
+  ffi
+  google-protobuf
+  rspec-rails (~> 6.0)
+  sass-embedded
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "gems", Name: "ffi", Version: "1.15.5"},
+		{Provider: "gems", Name: "google-protobuf", Version: "3.24.4"},
+		{Provider: "gems", Name: "rspec-rails", Version: "6.0.3"},
+		{Provider: "gems", Name: "sass-embedded", Version: "1.69.0-rc1"},
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package importer parses dependency manifests and maps dependencies to
+// NewReleases projects that can be tracked with ProjectsService.Add.
+package importer // import "newreleases.io/newreleases/importer"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"newreleases.io/newreleases"
+)
+
+// Ref references a NewReleases project by its provider and name, together
+// with the version of the dependency that is currently used, if it is known.
+type Ref struct {
+	Provider newreleases.Provider
+	Name     string
+	Version  string
+	Note     string
+}
+
+func (r Ref) String() (s string) {
+	s = string(r.Provider) + "/" + r.Name
+	if r.Version != "" {
+		s += "@" + r.Version
+	}
+	return s
+}
+
+// Unique returns references without duplicates, preserving the order of the
+// first occurrence of every provider and name pair.
+func Unique(refs []Ref) (unique []Ref) {
+	seen := make(map[string]struct{}, len(refs))
+	for _, r := range refs {
+		k := string(r.Provider) + "/" + r.Name
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		unique = append(unique, r)
+	}
+	return unique
+}
+
+// ParseFile parses a manifest file based on its file name and returns
+// references for all its dependencies. Supported file names are Cargo.toml,
+// Cargo.lock, Gemfile.lock, composer.json, pom.xml, Dockerfiles, Docker
+// Compose files and GitHub Actions workflows in the .github/workflows
+// directory. Kubernetes manifests can not be detected by their names and
+// should be parsed with ParseKubernetes.
+func ParseFile(filename string) (refs []Ref, err error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	base := filepath.Base(filename)
+	switch name := strings.ToLower(base); {
+	case isWorkflowFile(filename):
+		return ParseWorkflow(f)
+	case isDockerfile(name):
+		return ParseDockerfile(f)
+	case isComposeFile(name):
+		return ParseCompose(f)
+	}
+
+	switch strings.ToLower(base) {
+	case "cargo.toml":
+		return ParseCargoTOML(f)
+	case "cargo.lock":
+		return ParseCargoLock(f)
+	case "gemfile.lock":
+		return ParseGemfileLock(f)
+	case "composer.json":
+		return ParseComposerJSON(f)
+	case "pom.xml":
+		return ParsePOM(f)
+	default:
+		return nil, fmt.Errorf("unsupported manifest %s", base)
+	}
+}
+
+// ParseFiles parses all manifest files and returns a unified list of
+// references without duplicates.
+func ParseFiles(filenames ...string) (refs []Ref, err error) {
+	for _, filename := range filenames {
+		r, err := ParseFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		refs = append(refs, r...)
+	}
+	return Unique(refs), nil
+}
+
+// Add adds projects for all references using ProjectsService.Add with the
+// provided options. If options do not set the note, the reference note is
+// used instead. Projects that are added before an error occurs are returned
+// together with the error.
+func Add(ctx context.Context, s *newreleases.ProjectsService, refs []Ref, o *newreleases.ProjectOptions) (projects []newreleases.Project, err error) {
+	for _, r := range refs {
+		p, err := s.Add(ctx, r.Provider, r.Name, r.options(o))
+		if err != nil {
+			return projects, fmt.Errorf("add %s/%s: %w", r.Provider, r.Name, err)
+		}
+		projects = append(projects, *p)
+	}
+	return projects, nil
+}
+
+func (r Ref) options(o *newreleases.ProjectOptions) *newreleases.ProjectOptions {
+	if r.Note == "" || (o != nil && o.Note != nil) {
+		return o
+	}
+	var opts newreleases.ProjectOptions
+	if o != nil {
+		opts = *o
+	}
+	opts.Note = newreleases.String(r.Note)
+	return &opts
+}
+
+func isDockerfile(name string) bool {
+	return name == "dockerfile" || strings.HasPrefix(name, "dockerfile.") || strings.HasSuffix(name, ".dockerfile")
+}
+
+func isComposeFile(name string) bool {
+	ext := filepath.Ext(name)
+	if ext != ".yml" && ext != ".yaml" {
+		return false
+	}
+	name = strings.TrimSuffix(name, ext)
+	return name == "compose" || name == "docker-compose" || strings.HasPrefix(name, "docker-compose.") || strings.HasPrefix(name, "compose.")
+}
+
+// githubRepository returns the owner/repo name of a GitHub repository from its
+// git or web URL.
+func githubRepository(u string) (repo string, ok bool) {
+	if i := strings.IndexAny(u, "?#"); i >= 0 {
+		u = u[:i]
+	}
+	for _, prefix := range []string{
+		"https://github.com/",
+		"http://github.com/",
+		"ssh://git@github.com/",
+		"git://github.com/",
+		"git@github.com:",
+		"github.com/",
+	} {
+		if strings.HasPrefix(u, prefix) {
+			u = strings.TrimPrefix(u, prefix)
+			parts := strings.Split(strings.TrimSuffix(strings.TrimSuffix(u, "/"), ".git"), "/")
+			if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+				return "", false
+			}
+			return parts[0] + "/" + strings.TrimSuffix(parts[1], ".git"), true
+		}
+	}
+	return "", false
+}
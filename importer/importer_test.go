@@ -0,0 +1,102 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/importer"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestUnique(t *testing.T) {
+	got := importer.Unique([]importer.Ref{
+		{Provider: "cargo", Name: "serde", Version: "1.0"},
+		{Provider: "npm", Name: "serde"},
+		{Provider: "cargo", Name: "serde", Version: "1.0.188"},
+	})
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "cargo", Name: "serde", Version: "1.0"},
+		{Provider: "npm", Name: "serde"},
+	})
+}
+
+func TestParseFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "composer.json"), `{"require": {"php": ">=8.1", "monolog/monolog": "^3.0"}}`)
+	writeFile(t, filepath.Join(dir, "Cargo.toml"), "[dependencies]\nserde = \"1.0\"\n")
+
+	got, err := importer.ParseFiles(filepath.Join(dir, "composer.json"), filepath.Join(dir, "Cargo.toml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, []importer.Ref{
+		{Provider: "packagist", Name: "monolog/monolog", Version: "^3.0"},
+		{Provider: "cargo", Name: "serde", Version: "1.0"},
+	})
+
+	writeFile(t, filepath.Join(dir, "unknown.txt"), "")
+	if _, err := importer.ParseFiles(filepath.Join(dir, "unknown.txt")); err == nil {
+		t.Fatal("expected error for unsupported manifest")
+	}
+}
+
+func TestAdd(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	var got []map[string]interface{}
+	mux.HandleFunc("/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			panic(err)
+		}
+		got = append(got, req)
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_ = json.NewEncoder(w).Encode(newreleases.Project{
+			Provider: newreleases.Provider(req["provider"].(string)),
+			Name:     req["name"].(string),
+		})
+	})
+
+	projects, err := importer.Add(context.Background(), client.Projects, []importer.Ref{
+		{Provider: "cargo", Name: "serde", Version: "1.0"},
+		{Provider: "dockerhub", Name: "library/nginx", Note: "tag 1.25"},
+	}, &newreleases.ProjectOptions{
+		ExcludePrereleases: newreleases.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "projects", projects, []newreleases.Project{
+		{Provider: "cargo", Name: "serde"},
+		{Provider: "dockerhub", Name: "library/nginx"},
+	})
+	testutil.AssertEqual(t, "note", got[0]["note"], nil)
+	testutil.AssertEqual(t, "note", got[1]["note"], "tag 1.25")
+	testutil.AssertEqual(t, "exclude prereleases", got[1]["exclude_prereleases"], true)
+}
+
+func writeFile(t *testing.T, filename, data string) {
+	t.Helper()
+
+	if err := os.WriteFile(filename, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"newreleases.io/newreleases"
+)
+
+type pom struct {
+	GroupID    string        `xml:"groupId"`
+	ArtifactID string        `xml:"artifactId"`
+	Version    string        `xml:"version"`
+	Parent     pomArtifact   `xml:"parent"`
+	Properties pomProperties `xml:"properties"`
+
+	Dependencies         []pomArtifact `xml:"dependencies>dependency"`
+	DependencyManagement []pomArtifact `xml:"dependencyManagement>dependencies>dependency"`
+}
+
+type pomArtifact struct {
+	GroupID    string `xml:"groupId"`
+	ArtifactID string `xml:"artifactId"`
+	Version    string `xml:"version"`
+}
+
+type pomProperties struct {
+	Properties []struct {
+		XMLName xml.Name
+		Value   string `xml:",chardata"`
+	} `xml:",any"`
+}
+
+// ParsePOM returns references for all dependencies declared in a Maven pom.xml
+// file, including the ones in the dependencyManagement section. Versions are
+// resolved from properties and dependencies without a version take the
+// managed one. Project names have the groupId:artifactId format.
+func ParsePOM(r io.Reader) (refs []Ref, err error) {
+	var p pom
+	if err := xml.NewDecoder(r).Decode(&p); err != nil {
+		return nil, err
+	}
+
+	properties := make(map[string]string)
+	for _, prop := range p.Properties.Properties {
+		properties[prop.XMLName.Local] = strings.TrimSpace(prop.Value)
+	}
+	groupID := p.GroupID
+	if groupID == "" {
+		groupID = p.Parent.GroupID
+	}
+	version := p.Version
+	if version == "" {
+		version = p.Parent.Version
+	}
+	for k, v := range map[string]string{
+		"project.groupId":        groupID,
+		"project.artifactId":     p.ArtifactID,
+		"project.version":        version,
+		"project.parent.groupId": p.Parent.GroupID,
+		"project.parent.version": p.Parent.Version,
+	} {
+		properties[k] = strings.TrimSpace(v)
+		properties[strings.TrimPrefix(k, "project.")] = strings.TrimSpace(v)
+	}
+
+	managed := make(map[string]string)
+	for _, a := range p.DependencyManagement {
+		a = a.resolve(properties)
+		managed[a.name()] = a.Version
+	}
+
+	for _, a := range p.Dependencies {
+		a = a.resolve(properties)
+		if a.GroupID == "" || a.ArtifactID == "" {
+			continue
+		}
+		if a.Version == "" {
+			a.Version = managed[a.name()]
+		}
+		refs = append(refs, Ref{Provider: newreleases.ProviderMaven, Name: a.name(), Version: a.Version})
+	}
+	for _, a := range p.DependencyManagement {
+		a = a.resolve(properties)
+		if a.GroupID == "" || a.ArtifactID == "" {
+			continue
+		}
+		refs = append(refs, Ref{Provider: newreleases.ProviderMaven, Name: a.name(), Version: a.Version})
+	}
+	return Unique(refs), nil
+}
+
+func (a pomArtifact) name() string {
+	return a.GroupID + ":" + a.ArtifactID
+}
+
+func (a pomArtifact) resolve(properties map[string]string) pomArtifact {
+	return pomArtifact{
+		GroupID:    resolveMavenProperties(a.GroupID, properties),
+		ArtifactID: resolveMavenProperties(a.ArtifactID, properties),
+		Version:    resolveMavenProperties(a.Version, properties),
+	}
+}
+
+// resolveMavenProperties replaces ${name} references with property values.
+// Properties may reference other properties and unknown ones are left as they
+// are.
+func resolveMavenProperties(s string, properties map[string]string) string {
+	s = strings.TrimSpace(s)
+	// Limit the depth of nested references to avoid cycles.
+	for depth := 0; depth < 10 && strings.Contains(s, "${"); depth++ {
+		var b strings.Builder
+		var changed bool
+		for {
+			start := strings.Index(s, "${")
+			if start < 0 {
+				b.WriteString(s)
+				break
+			}
+			end := strings.Index(s[start:], "}")
+			if end < 0 {
+				b.WriteString(s)
+				break
+			}
+			end += start
+			b.WriteString(s[:start])
+			if v, ok := properties[s[start+2:end]]; ok {
+				b.WriteString(v)
+				changed = true
+			} else {
+				b.WriteString(s[start : end+1])
+			}
+			s = s[end+1:]
+		}
+		s = b.String()
+		if !changed {
+			break
+		}
+	}
+	return s
+}
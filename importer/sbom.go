@@ -0,0 +1,161 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"newreleases.io/newreleases"
+)
+
+// Component holds information about a software component from an SBOM
+// document.
+type Component struct {
+	Name       string
+	Version    string
+	PackageURL string
+}
+
+// ErrUnsupportedSBOM is returned by ParseSBOM if the document is neither
+// a CycloneDX nor an SPDX JSON document.
+var ErrUnsupportedSBOM = errors.New("unsupported sbom format")
+
+// ParseSBOM detects the format of a CycloneDX or SPDX JSON document and
+// returns references for all components whose package URLs can be mapped to
+// NewReleases projects. Components without a package URL or with a package
+// type that is not supported are returned as unmapped.
+func ParseSBOM(r io.Reader) (refs []Ref, unmapped []Component, err error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	var header struct {
+		BOMFormat   string `json:"bomFormat"`
+		SPDXVersion string `json:"spdxVersion"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case header.BOMFormat == "CycloneDX":
+		return ParseCycloneDX(bytes.NewReader(data))
+	case header.SPDXVersion != "":
+		return ParseSPDX(bytes.NewReader(data))
+	default:
+		return nil, nil, ErrUnsupportedSBOM
+	}
+}
+
+type cycloneDXComponent struct {
+	Name       string               `json:"name"`
+	Group      string               `json:"group"`
+	Version    string               `json:"version"`
+	PURL       string               `json:"purl"`
+	Components []cycloneDXComponent `json:"components"`
+}
+
+// ParseCycloneDX returns references for all components, including nested
+// ones, of a CycloneDX JSON document. The described component from the
+// document metadata is not included.
+func ParseCycloneDX(r io.Reader) (refs []Ref, unmapped []Component, err error) {
+	var bom struct {
+		Components []cycloneDXComponent `json:"components"`
+	}
+	if err := json.NewDecoder(r).Decode(&bom); err != nil {
+		return nil, nil, err
+	}
+
+	var components []Component
+	var walk func(cc []cycloneDXComponent)
+	walk = func(cc []cycloneDXComponent) {
+		for _, c := range cc {
+			name := c.Name
+			if c.Group != "" {
+				name = c.Group + "/" + c.Name
+			}
+			components = append(components, Component{
+				Name:       name,
+				Version:    c.Version,
+				PackageURL: c.PURL,
+			})
+			walk(c.Components)
+		}
+	}
+	walk(bom.Components)
+
+	refs, unmapped = mapComponents(components)
+	return refs, unmapped, nil
+}
+
+// ParseSPDX returns references for all packages of an SPDX JSON document that
+// have a purl external reference.
+func ParseSPDX(r io.Reader) (refs []Ref, unmapped []Component, err error) {
+	var doc struct {
+		Packages []struct {
+			Name         string `json:"name"`
+			VersionInfo  string `json:"versionInfo"`
+			ExternalRefs []struct {
+				ReferenceType    string `json:"referenceType"`
+				ReferenceLocator string `json:"referenceLocator"`
+			} `json:"externalRefs"`
+		} `json:"packages"`
+	}
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, nil, err
+	}
+
+	components := make([]Component, 0, len(doc.Packages))
+	for _, p := range doc.Packages {
+		c := Component{
+			Name:    p.Name,
+			Version: p.VersionInfo,
+		}
+		for _, ref := range p.ExternalRefs {
+			if ref.ReferenceType == "purl" {
+				c.PackageURL = ref.ReferenceLocator
+				break
+			}
+		}
+		components = append(components, c)
+	}
+
+	refs, unmapped = mapComponents(components)
+	return refs, unmapped, nil
+}
+
+func mapComponents(components []Component) (refs []Ref, unmapped []Component) {
+	for _, c := range components {
+		ref, ok := ParsePackageURL(c.PackageURL)
+		if !ok {
+			unmapped = append(unmapped, c)
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	return Unique(refs), unmapped
+}
+
+// ParsePackageURL maps a package URL, such as pkg:npm/%40angular/core@16.2.0,
+// to a NewReleases project reference with the package version. Package URLs
+// that can not be mapped to a NewReleases project are reported with ok set to
+// false.
+func ParsePackageURL(purl string) (ref Ref, ok bool) {
+	p, r, err := newreleases.ParsePackageURL(purl)
+	if err != nil {
+		return Ref{}, false
+	}
+	ref = Ref{
+		Provider: p.Provider,
+		Name:     p.Name,
+	}
+	if r != nil {
+		ref.Version = r.Version
+	}
+	return ref, true
+}
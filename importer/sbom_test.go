@@ -0,0 +1,180 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer_test
+
+import (
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases/importer"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestParsePackageURL(t *testing.T) {
+	for _, tc := range []struct {
+		purl string
+		want importer.Ref
+		ok   bool
+	}{
+		{
+			purl: "pkg:golang/github.com/gorilla/mux@v1.8.0",
+			want: importer.Ref{Provider: "go", Name: "github.com/gorilla/mux", Version: "v1.8.0"},
+			ok:   true,
+		},
+		{
+			purl: "pkg:npm/%40angular/core@16.2.0",
+			want: importer.Ref{Provider: "npm", Name: "@angular/core", Version: "16.2.0"},
+			ok:   true,
+		},
+		{
+			purl: "pkg:pypi/Django_Rest.framework@3.14.0",
+			want: importer.Ref{Provider: "pypi", Name: "django-rest-framework", Version: "3.14.0"},
+			ok:   true,
+		},
+		{
+			purl: "pkg:maven/org.apache.commons/commons-lang3@3.13.0?type=jar",
+			want: importer.Ref{Provider: "maven", Name: "org.apache.commons:commons-lang3", Version: "3.13.0"},
+			ok:   true,
+		},
+		{
+			purl: "pkg:docker/nginx@1.25",
+			want: importer.Ref{Provider: "dockerhub", Name: "library/nginx", Version: "1.25"},
+			ok:   true,
+		},
+		{
+			purl: "pkg:docker/prometheus/prometheus@v2.47.0?repository_url=quay.io",
+			want: importer.Ref{Provider: "quay", Name: "prometheus/prometheus", Version: "v2.47.0"},
+			ok:   true,
+		},
+		{
+			purl: "pkg:oci/backup@sha256%3Aabcdef?repository_url=ghcr.io/example/backup",
+			want: importer.Ref{Provider: "ghcr", Name: "example/backup", Version: "sha256:abcdef"},
+			ok:   true,
+		},
+		{
+			purl: "pkg:oci/backup@sha256%3Aabcdef",
+		},
+		{
+			purl: "pkg:cargo/serde@1.0.188",
+			want: importer.Ref{Provider: "cargo", Name: "serde", Version: "1.0.188"},
+			ok:   true,
+		},
+		{
+			purl: "pkg:maven/commons-lang3@3.13.0",
+		},
+		{
+			purl: "pkg:deb/debian/curl@7.88.1",
+		},
+		{
+			purl: "https://example.com",
+		},
+	} {
+		t.Run(tc.purl, func(t *testing.T) {
+			got, ok := importer.ParsePackageURL(tc.purl)
+			testutil.AssertEqual(t, "ok", ok, tc.ok)
+			testutil.AssertEqual(t, "ref", got, tc.want)
+		})
+	}
+}
+
+func TestParseSBOM_cycloneDX(t *testing.T) {
+	refs, unmapped, err := importer.ParseSBOM(strings.NewReader(`{
+		"bomFormat": "CycloneDX",
+		"specVersion": "1.5",
+		"metadata": {
+			"component": {"name": "app", "purl": "pkg:golang/example.com/app"}
+		},
+		"components": [
+			{
+				"type": "library",
+				"name": "mux",
+				"group": "github.com/gorilla",
+				"version": "v1.8.0",
+				"purl": "pkg:golang/github.com/gorilla/mux@v1.8.0"
+			},
+			{
+				"type": "library",
+				"name": "core",
+				"group": "@angular",
+				"version": "16.2.0",
+				"purl": "pkg:npm/%40angular/core@16.2.0",
+				"components": [
+					{"name": "tslib", "version": "2.6.2", "purl": "pkg:npm/tslib@2.6.2"}
+				]
+			},
+			{
+				"type": "operating-system",
+				"name": "debian",
+				"version": "12"
+			},
+			{
+				"type": "library",
+				"name": "curl",
+				"version": "7.88.1",
+				"purl": "pkg:deb/debian/curl@7.88.1"
+			}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "refs", refs, []importer.Ref{
+		{Provider: "go", Name: "github.com/gorilla/mux", Version: "v1.8.0"},
+		{Provider: "npm", Name: "@angular/core", Version: "16.2.0"},
+		{Provider: "npm", Name: "tslib", Version: "2.6.2"},
+	})
+	testutil.AssertEqual(t, "unmapped", unmapped, []importer.Component{
+		{Name: "debian", Version: "12"},
+		{Name: "curl", Version: "7.88.1", PackageURL: "pkg:deb/debian/curl@7.88.1"},
+	})
+}
+
+func TestParseSBOM_spdx(t *testing.T) {
+	refs, unmapped, err := importer.ParseSBOM(strings.NewReader(`{
+		"spdxVersion": "SPDX-2.3",
+		"SPDXID": "SPDXRef-DOCUMENT",
+		"packages": [
+			{
+				"name": "requests",
+				"versionInfo": "2.31.0",
+				"externalRefs": [
+					{
+						"referenceCategory": "SECURITY",
+						"referenceType": "cpe23Type",
+						"referenceLocator": "cpe:2.3:a:python:requests:2.31.0:*:*:*:*:*:*:*"
+					},
+					{
+						"referenceCategory": "PACKAGE-MANAGER",
+						"referenceType": "purl",
+						"referenceLocator": "pkg:pypi/requests@2.31.0"
+					}
+				]
+			},
+			{
+				"name": "alpine",
+				"versionInfo": "3.18.4"
+			}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "refs", refs, []importer.Ref{
+		{Provider: "pypi", Name: "requests", Version: "2.31.0"},
+	})
+	testutil.AssertEqual(t, "unmapped", unmapped, []importer.Component{
+		{Name: "alpine", Version: "3.18.4"},
+	})
+}
+
+func TestParseSBOM_unsupported(t *testing.T) {
+	_, _, err := importer.ParseSBOM(strings.NewReader(`{"name": "package.json"}`))
+	if err != importer.ErrUnsupportedSBOM {
+		t.Fatalf("got error %v, want %v", err, importer.ErrUnsupportedSBOM)
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// tomlEntry is a single key and value pair from a TOML document together with
+// the table that it belongs to. Entries in array tables have the index of the
+// table element.
+type tomlEntry struct {
+	Table []string
+	Index int
+	Key   []string
+	Value interface{}
+}
+
+// parseTOML parses a subset of TOML that is sufficient for reading package
+// manifests and lock files. Values are decoded as strings, bools, arrays
+// ([]interface{}) and inline tables (map[string]interface{}), while numbers and
+// dates are kept as raw strings.
+func parseTOML(r io.Reader) (entries []tomlEntry, err error) {
+	var (
+		table   []string
+		indexes = make(map[string]int)
+		index   int
+		lineNo  int
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			array := strings.HasPrefix(line, "[[")
+			p := &tomlParser{s: line}
+			if array {
+				p.i = 2
+			} else {
+				p.i = 1
+			}
+			table, err = p.key()
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			closing := "]"
+			if array {
+				closing = "]]"
+			}
+			p.space()
+			if !strings.HasPrefix(p.s[p.i:], closing) {
+				return nil, fmt.Errorf("line %d: invalid table header", lineNo)
+			}
+			index = 0
+			if array {
+				k := strings.Join(table, "\x00")
+				index = indexes[k]
+				indexes[k]++
+			}
+			continue
+		}
+
+		// Values may span multiple lines, so lines are appended until the
+		// value is complete.
+		for !tomlComplete(line) && scanner.Scan() {
+			lineNo++
+			line += "\n" + scanner.Text()
+		}
+
+		p := &tomlParser{s: line}
+		key, err := p.key()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		p.space()
+		if !p.consume('=') {
+			return nil, fmt.Errorf("line %d: missing '='", lineNo)
+		}
+		v, err := p.value()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		entries = append(entries, tomlEntry{
+			Table: table,
+			Index: index,
+			Key:   key,
+			Value: v,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// tomlComplete reports whether the line contains a complete key and value pair
+// with all brackets, braces and multi-line strings closed.
+func tomlComplete(s string) bool {
+	var depth int
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"', '\'':
+			q := string([]byte{c, c, c})
+			if strings.HasPrefix(s[i:], q) {
+				end := strings.Index(s[i+3:], q)
+				if end < 0 {
+					return false
+				}
+				i += end + 5
+				continue
+			}
+			for i++; i < len(s) && s[i] != c; i++ {
+				if c == '"' && s[i] == '\\' {
+					i++
+				}
+			}
+		case '[', '{':
+			depth++
+		case ']', '}':
+			depth--
+		case '#':
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		}
+	}
+	return depth <= 0
+}
+
+type tomlParser struct {
+	s string
+	i int
+}
+
+var errTOMLUnexpectedEnd = errors.New("unexpected end of value")
+
+func (p *tomlParser) space() {
+	for p.i < len(p.s) {
+		switch p.s[p.i] {
+		case ' ', '\t', '\r', '\n':
+			p.i++
+		case '#':
+			for p.i < len(p.s) && p.s[p.i] != '\n' {
+				p.i++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *tomlParser) consume(c byte) bool {
+	if p.i < len(p.s) && p.s[p.i] == c {
+		p.i++
+		return true
+	}
+	return false
+}
+
+// key parses a bare, quoted or dotted key.
+func (p *tomlParser) key() (key []string, err error) {
+	for {
+		p.space()
+		if p.i >= len(p.s) {
+			return nil, errTOMLUnexpectedEnd
+		}
+		var part string
+		switch p.s[p.i] {
+		case '"', '\'':
+			part, err = p.string()
+			if err != nil {
+				return nil, err
+			}
+		default:
+			start := p.i
+			for p.i < len(p.s) && isTOMLBareKeyChar(p.s[p.i]) {
+				p.i++
+			}
+			if start == p.i {
+				return nil, fmt.Errorf("invalid key character %q", p.s[p.i])
+			}
+			part = p.s[start:p.i]
+		}
+		key = append(key, part)
+		p.space()
+		if !p.consume('.') {
+			return key, nil
+		}
+	}
+}
+
+func isTOMLBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+func (p *tomlParser) value() (v interface{}, err error) {
+	p.space()
+	if p.i >= len(p.s) {
+		return nil, errTOMLUnexpectedEnd
+	}
+	switch c := p.s[p.i]; c {
+	case '"', '\'':
+		return p.string()
+	case '[':
+		p.i++
+		var a []interface{}
+		for {
+			p.space()
+			if p.consume(']') {
+				return a, nil
+			}
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, v)
+			p.space()
+			if p.consume(',') {
+				continue
+			}
+			if !p.consume(']') {
+				return nil, errors.New("invalid array")
+			}
+			return a, nil
+		}
+	case '{':
+		p.i++
+		t := make(map[string]interface{})
+		for {
+			p.space()
+			if p.consume('}') {
+				return t, nil
+			}
+			key, err := p.key()
+			if err != nil {
+				return nil, err
+			}
+			p.space()
+			if !p.consume('=') {
+				return nil, errors.New("missing '=' in inline table")
+			}
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			setTOMLKey(t, key, v)
+			p.space()
+			if p.consume(',') {
+				continue
+			}
+			if !p.consume('}') {
+				return nil, errors.New("invalid inline table")
+			}
+			return t, nil
+		}
+	default:
+		start := p.i
+		for p.i < len(p.s) && !strings.ContainsRune(",]}#\n", rune(p.s[p.i])) {
+			p.i++
+		}
+		raw := strings.TrimSpace(p.s[start:p.i])
+		switch raw {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "":
+			return nil, errors.New("missing value")
+		}
+		return raw, nil
+	}
+}
+
+func (p *tomlParser) string() (s string, err error) {
+	q := p.s[p.i]
+	if strings.HasPrefix(p.s[p.i:], string([]byte{q, q, q})) {
+		p.i += 3
+		end := strings.Index(p.s[p.i:], string([]byte{q, q, q}))
+		if end < 0 {
+			return "", errTOMLUnexpectedEnd
+		}
+		s = strings.TrimPrefix(p.s[p.i:p.i+end], "\n")
+		p.i += end + 3
+		return s, nil
+	}
+	start := p.i
+	for p.i++; p.i < len(p.s); p.i++ {
+		switch p.s[p.i] {
+		case '\\':
+			if q == '"' {
+				p.i++
+			}
+		case q:
+			p.i++
+			if q == '\'' {
+				return p.s[start+1 : p.i-1], nil
+			}
+			return strconv.Unquote(p.s[start:p.i])
+		}
+	}
+	return "", errTOMLUnexpectedEnd
+}
+
+func setTOMLKey(t map[string]interface{}, key []string, v interface{}) {
+	for _, k := range key[:len(key)-1] {
+		sub, ok := t[k].(map[string]interface{})
+		if !ok {
+			sub = make(map[string]interface{})
+			t[k] = sub
+		}
+		t = sub
+	}
+	t[key[len(key)-1]] = v
+}
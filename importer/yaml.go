@@ -0,0 +1,207 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// readYAMLDocuments reads lines of all documents in a YAML stream. It does not
+// parse YAML, but it allows simple line based lookups of scalar values which
+// is sufficient for files like Compose files, Kubernetes manifests and GitHub
+// workflows.
+func readYAMLDocuments(r io.Reader) (documents [][]string, err error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		if line == "---" || strings.HasPrefix(line, "--- ") {
+			if len(lines) > 0 {
+				documents = append(documents, lines)
+			}
+			lines = nil
+			continue
+		}
+		if line == "..." {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) > 0 {
+		documents = append(documents, lines)
+	}
+	return documents, nil
+}
+
+// yamlValues returns scalar values of mapping entries with paths of keys that
+// match any of the patterns. Patterns are keys separated by slashes, where
+// "*" matches any key and "**" matches any number of keys. Sequences do not
+// add keys to paths, so the pattern "services/*/image" matches the entry in
+// "services: {web: {image: nginx}}" and "containers/image" the entries in
+// "containers: [{image: nginx}]". Paths are tracked by indentation and
+// contents of block scalars are skipped.
+func yamlValues(lines []string, patterns ...string) (values []string) {
+	type parent struct {
+		indent int
+		key    string
+	}
+	var (
+		parents     []parent
+		blockIndent = -1
+	)
+	for _, line := range lines {
+		text := strings.TrimLeft(line, " ")
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		indent := len(line) - len(text)
+		if blockIndent >= 0 {
+			if indent > blockIndent {
+				continue
+			}
+			blockIndent = -1
+		}
+
+		// Keys at the same or a deeper indentation are closed, except that
+		// a sequence item may be written at the indentation of the key of
+		// its sequence.
+		closed := indent
+		if text == "-" || strings.HasPrefix(text, "- ") {
+			closed = indent + 1
+			for text == "-" || strings.HasPrefix(text, "- ") {
+				rest := strings.TrimLeft(text[1:], " ")
+				indent += len(text) - len(rest)
+				text = rest
+			}
+		}
+		for len(parents) > 0 && parents[len(parents)-1].indent >= closed {
+			parents = parents[:len(parents)-1]
+		}
+
+		key, value, ok := yamlEntry(text)
+		if !ok {
+			continue
+		}
+		path := make([]string, 0, len(parents)+1)
+		for _, p := range parents {
+			path = append(path, p.key)
+		}
+		path = append(path, key)
+		value = strings.TrimSpace(value)
+		switch {
+		case strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">"):
+			blockIndent = indent
+		case value == "" || strings.HasPrefix(value, "#"):
+			parents = append(parents, parent{indent: indent, key: key})
+		default:
+			for _, pattern := range patterns {
+				if v := yamlScalar(value); v != "" && yamlPathMatch(strings.Split(pattern, "/"), path) {
+					values = append(values, v)
+					break
+				}
+			}
+		}
+	}
+	return values
+}
+
+// yamlEntry splits a block mapping entry into its key and the rest of the
+// line after the colon.
+func yamlEntry(text string) (key, value string, ok bool) {
+	if text == "" {
+		return "", "", false
+	}
+	if text[0] == '"' || text[0] == '\'' {
+		end := strings.IndexByte(text[1:], text[0]) + 1
+		if end <= 0 || !strings.HasPrefix(text[end+1:], ":") {
+			return "", "", false
+		}
+		key, value = text[1:end], text[end+2:]
+	} else {
+		i := strings.Index(text+" ", ": ")
+		if i <= 0 {
+			return "", "", false
+		}
+		key, value = text[:i], text[i+1:]
+	}
+	if value != "" && value[0] != ' ' {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+// yamlPathMatch returns true if the path of keys matches the pattern.
+func yamlPathMatch(pattern, path []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(path); i++ {
+				if yamlPathMatch(pattern[1:], path[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(path) == 0 || pattern[0] != "*" && pattern[0] != path[0] {
+			return false
+		}
+		pattern, path = pattern[1:], path[1:]
+	}
+	return len(path) == 0
+}
+
+// yamlTopLevelValue returns the scalar value of a mapping entry with the
+// provided key that is not indented.
+func yamlTopLevelValue(lines []string, key string) string {
+	for _, line := range lines {
+		if v, ok := yamlEntryValue(line, key); ok {
+			return v
+		}
+	}
+	return ""
+}
+
+func yamlEntryValue(text, key string) (value string, ok bool) {
+	for _, k := range []string{key, `"` + key + `"`, "'" + key + "'"} {
+		if strings.HasPrefix(text, k+":") {
+			return yamlScalar(text[len(k)+1:]), true
+		}
+	}
+	return "", false
+}
+
+// yamlScalar returns an unquoted value of a plain or quoted flow scalar without
+// a trailing comment.
+func yamlScalar(s string) string {
+	s = strings.TrimSpace(s)
+	switch {
+	case strings.HasPrefix(s, `"`):
+		if end := strings.LastIndex(s, `"`); end > 0 {
+			if v, err := strconv.Unquote(s[:end+1]); err == nil {
+				return v
+			}
+			return s[1:end]
+		}
+	case strings.HasPrefix(s, "'"):
+		if end := strings.LastIndex(s, "'"); end > 0 {
+			return strings.ReplaceAll(s[1:end], "''", "'")
+		}
+	}
+	if i := strings.Index(s, " #"); i >= 0 {
+		s = s[:i]
+	}
+	if strings.HasPrefix(s, "|") || strings.HasPrefix(s, ">") {
+		// Block scalars are not supported.
+		return ""
+	}
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package httpcache provides a bounded cache of generated documents for HTTP
+// handlers, validation of query parameters that select cached documents, and
+// serving of documents with caching headers.
+package httpcache // import "newreleases.io/newreleases/internal/httpcache"
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"newreleases.io/newreleases"
+)
+
+// Defaults for New.
+const (
+	// DefaultMaxEntries is the number of values held by a cache if it is not
+	// set.
+	DefaultMaxEntries = 100
+	// DefaultTimeout limits the duration of generating a value if it is not
+	// set.
+	DefaultTimeout = time.Minute
+)
+
+// Cache holds generated values by key for a limited duration and up to a
+// limited number of keys. A value is generated only once for concurrent
+// requests with the same key.
+type Cache struct {
+	maxAge     time.Duration
+	maxEntries int
+	timeout    time.Duration
+
+	mu      sync.Mutex
+	entries map[string]entry
+	calls   map[string]*call
+}
+
+type entry struct {
+	value   interface{}
+	expires time.Time
+}
+
+type call struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// New returns a cache that holds values for maxAge, up to maxEntries values,
+// or DefaultMaxEntries if maxEntries is not positive. Generating a value is
+// limited to timeout, or DefaultTimeout if timeout is not positive.
+func New(maxAge time.Duration, maxEntries int, timeout time.Duration) *Cache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Cache{
+		maxAge:     maxAge,
+		maxEntries: maxEntries,
+		timeout:    timeout,
+		entries:    make(map[string]entry),
+		calls:      make(map[string]*call),
+	}
+}
+
+// Get returns the cached value for the key, or calls generate if it is not
+// cached or it has expired. Concurrent calls for the same key wait for a
+// single generate call. As its result is shared, generate is called with a
+// context that is not canceled with ctx, but only when the cache timeout
+// expires, and Get returns the ctx error if ctx is done before the value is
+// generated.
+func (c *Cache) Get(ctx context.Context, key string, generate func(ctx context.Context) (interface{}, error)) (v interface{}, err error) {
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && time.Now().Before(e.expires) {
+		c.mu.Unlock()
+		return e.value, nil
+	}
+	cl, ok := c.calls[key]
+	if !ok {
+		cl = &call{done: make(chan struct{})}
+		c.calls[key] = cl
+		go c.generate(key, cl, generate)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-cl.done:
+		return cl.value, cl.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *Cache) generate(key string, cl *call, generate func(ctx context.Context) (interface{}, error)) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	cl.value, cl.err = generate(ctx)
+	cancel()
+
+	c.mu.Lock()
+	delete(c.calls, key)
+	if cl.err == nil {
+		c.add(key, cl.value)
+	}
+	c.mu.Unlock()
+	close(cl.done)
+}
+
+// add stores the value, removing expired entries and, if the cache is full,
+// the entry that expires first. It must be called with the mutex locked.
+func (c *Cache) add(key string, value interface{}) {
+	now := time.Now()
+	for k, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		var oldest string
+		for k, e := range c.entries {
+			if oldest == "" || e.expires.Before(c.entries[oldest].expires) {
+				oldest = k
+			}
+		}
+		delete(c.entries, oldest)
+	}
+	c.entries[key] = entry{value: value, expires: now.Add(c.maxAge)}
+}
+
+// Serve writes the body with the content type, the Cache-Control header with
+// the cache duration, and the ETag and Last-Modified headers, answering
+// conditional requests with the Not Modified status.
+func (c *Cache) Serve(w http.ResponseWriter, r *http.Request, contentType string, modified time.Time, body []byte) {
+	sum := sha256.Sum256(body)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(c.maxAge/time.Second)))
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	http.ServeContent(w, r, "", modified, bytes.NewReader(body))
+}
+
+// AllowMethod returns true if the request method is GET or HEAD, and
+// otherwise responds with the Method Not Allowed status.
+func AllowMethod(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return true
+	}
+	w.Header().Set("Allow", "GET, HEAD")
+	http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	return false
+}
+
+// Error responds with the Not Found status if err wraps ErrNotFound, and with
+// the Bad Gateway status for other errors of the API.
+func Error(w http.ResponseWriter, err error) {
+	status := http.StatusBadGateway
+	if errors.Is(err, newreleases.ErrNotFound) {
+		status = http.StatusNotFound
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+var idPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// IDs returns sorted unique identifiers from values of a query parameter, or
+// an error if any of them is not a valid identifier or if there are more
+// than max of them.
+func IDs(name string, values []string, max int) (ids []string, err error) {
+	seen := make(map[string]struct{}, len(values))
+	for _, id := range values {
+		if !idPattern.MatchString(id) {
+			return nil, fmt.Errorf("invalid %s %q", name, id)
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	if len(ids) > max {
+		return nil, fmt.Errorf("too many %s parameters, at most %d are allowed", name, max)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// Provider returns an error if the value of the provider query parameter is
+// not empty and it is not a known provider.
+func Provider(value string) (provider newreleases.Provider, err error) {
+	if value == "" {
+		return "", nil
+	}
+	provider = newreleases.Provider(value)
+	if _, ok := provider.Info(); !ok {
+		return "", fmt.Errorf("unknown provider %q", value)
+	}
+	return provider, nil
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httpcache_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/internal/httpcache"
+	"newreleases.io/newreleases/internal/testutil"
+)
+
+func TestCache_Get(t *testing.T) {
+	c := httpcache.New(time.Hour, 2, 0)
+
+	var calls int32
+	generate := func(v string) func(ctx context.Context) (interface{}, error) {
+		return func(ctx context.Context) (interface{}, error) {
+			atomic.AddInt32(&calls, 1)
+			return v, nil
+		}
+	}
+	get := func(key string) interface{} {
+		t.Helper()
+		v, err := c.Get(context.Background(), key, generate(key))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	testutil.AssertEqual(t, "a", get("a"), "a")
+	testutil.AssertEqual(t, "cached a", get("a"), "a")
+	testutil.AssertEqual(t, "calls", atomic.LoadInt32(&calls), int32(1))
+
+	// The cache holds two values, so adding the third one removes the value
+	// that expires first.
+	get("b")
+	get("c")
+	testutil.AssertEqual(t, "calls before eviction", atomic.LoadInt32(&calls), int32(3))
+	get("c")
+	get("b")
+	testutil.AssertEqual(t, "calls for cached values", atomic.LoadInt32(&calls), int32(3))
+	get("a")
+	testutil.AssertEqual(t, "calls for evicted value", atomic.LoadInt32(&calls), int32(4))
+}
+
+func TestCache_Get_error(t *testing.T) {
+	c := httpcache.New(time.Hour, 0, 0)
+
+	var calls int32
+	errTest := errors.New("test")
+	generate := func(ctx context.Context) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, errTest
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := c.Get(context.Background(), "a", generate); !errors.Is(err, errTest) {
+			t.Fatalf("got error %v, want %v", err, errTest)
+		}
+	}
+	testutil.AssertEqual(t, "calls", atomic.LoadInt32(&calls), int32(2))
+}
+
+func TestCache_Get_timeout(t *testing.T) {
+	c := httpcache.New(time.Hour, 0, 10*time.Millisecond)
+
+	generate := func(ctx context.Context) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if _, err := c.Get(context.Background(), "a", generate); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestCache_Get_concurrent(t *testing.T) {
+	c := httpcache.New(time.Hour, 0, 0)
+
+	var calls int32
+	release := make(chan struct{})
+	generate := func(ctx context.Context) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "a", nil
+	}
+
+	// The first caller goes away before the value is generated, while the
+	// others still receive it.
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+	go func() {
+		_, err := c.Get(ctx, "a", generate)
+		canceled <- err
+	}()
+
+	const n = 10
+	var wg sync.WaitGroup
+	results := make([]interface{}, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, err := c.Get(context.Background(), "a", generate)
+			if err != nil {
+				t.Error(err)
+			}
+			results[i] = v
+		}(i)
+	}
+
+	cancel()
+	if err := <-canceled; !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	// Let the waiting callers reach the cache before the value is generated.
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i, v := range results {
+		if v != "a" {
+			t.Errorf("result %d: got %v, want a", i, v)
+		}
+	}
+	testutil.AssertEqual(t, "calls", atomic.LoadInt32(&calls), int32(1))
+}
+
+func TestCache_Serve(t *testing.T) {
+	c := httpcache.New(time.Hour, 0, 0)
+	modified := testutil.Date("2023-06-01")
+
+	w := httptest.NewRecorder()
+	c.Serve(w, httptest.NewRequest(http.MethodGet, "/", nil), "text/plain; charset=utf-8", modified, []byte("hello"))
+
+	testutil.AssertEqual(t, "status", w.Code, http.StatusOK)
+	testutil.AssertEqual(t, "content type", w.Header().Get("Content-Type"), "text/plain; charset=utf-8")
+	testutil.AssertEqual(t, "cache control", w.Header().Get("Cache-Control"), "public, max-age=3600")
+	testutil.AssertEqual(t, "last modified", w.Header().Get("Last-Modified"), "Thu, 01 Jun 2023 00:00:00 GMT")
+	testutil.AssertEqual(t, "body", w.Body.String(), "hello")
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("If-None-Match", w.Header().Get("ETag"))
+	w = httptest.NewRecorder()
+	c.Serve(w, r, "text/plain; charset=utf-8", modified, []byte("hello"))
+	testutil.AssertEqual(t, "not modified status", w.Code, http.StatusNotModified)
+}
+
+func TestError(t *testing.T) {
+	w := httptest.NewRecorder()
+	httpcache.Error(w, newreleases.ErrNotFound)
+	testutil.AssertEqual(t, "not found", w.Code, http.StatusNotFound)
+
+	w = httptest.NewRecorder()
+	httpcache.Error(w, errors.New("test"))
+	testutil.AssertEqual(t, "other", w.Code, http.StatusBadGateway)
+}
+
+func TestIDs(t *testing.T) {
+	got, err := httpcache.IDs("tag", []string{"b", "a", "b"}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "", got, []string{"a", "b"})
+
+	for _, values := range [][]string{
+		{"a", "b", "c"},
+		{""},
+		{"a,b"},
+		{"a|b"},
+	} {
+		if _, err := httpcache.IDs("tag", values, 2); err == nil {
+			t.Errorf("%q: expected error", values)
+		}
+	}
+}
+
+func TestProvider(t *testing.T) {
+	for _, p := range []string{"", "github"} {
+		got, err := httpcache.Provider(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testutil.AssertEqual(t, p, got, newreleases.Provider(p))
+	}
+	if _, err := httpcache.Provider("githab"); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package testutil provides helpers for tests of packages that use the
+// NewReleases API client.
+package testutil // import "newreleases.io/newreleases/internal/testutil"
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"newreleases.io/newreleases"
+)
+
+// NewClient returns a client for a test HTTP server and the multiplexer that
+// handles its requests. The teardown function closes the server.
+func NewClient(t testing.TB) (client *newreleases.Client, mux *http.ServeMux, teardown func()) {
+	t.Helper()
+
+	mux = http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	baseURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client = newreleases.NewClient("", &newreleases.ClientOptions{
+		BaseURL:    baseURL,
+		HTTPClient: server.Client(),
+	})
+
+	return client, mux, server.Close
+}
+
+// Handle registers a handler for the path that responds with the JSON body.
+func Handle(mux *http.ServeMux, path, body string) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintln(w, body)
+	})
+}
+
+// Date parses a date in the YYYY-MM-DD format and panics if it is not valid.
+func Date(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// AssertEqual reports a test error if got and want are not deeply equal.
+func AssertEqual(t testing.TB, name string, got, want interface{}) {
+	t.Helper()
+
+	if name != "" {
+		name += ": "
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%sgot %+v, want %+v", name, got, want)
+	}
+}
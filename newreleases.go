@@ -225,11 +225,17 @@ func responseErrorHandler(r *http.Response) (err error) {
 }
 
 // decodeBadRequest parses the body of HTTP response that contains a list of
-// errors as the result of bad request data.
+// errors as the result of bad request data. Errors may be plain messages or
+// objects with a message and a name of the field that the message refers to.
 func decodeBadRequest(r *http.Response) (err error) {
 
 	type badRequestResponse struct {
-		Errors []string `json:"errors"`
+		Errors []json.RawMessage `json:"errors"`
+	}
+
+	type fieldError struct {
+		Message string `json:"message"`
+		Field   string `json:"field"`
 	}
 
 	if !strings.Contains(r.Header.Get("Content-Type"), "application/json") {
@@ -242,7 +248,20 @@ func decodeBadRequest(r *http.Response) (err error) {
 		}
 		return err
 	}
-	return NewBadRequestError(e.Errors...)
+	details := make([]BadRequestDetail, 0, len(e.Errors))
+	for _, raw := range e.Errors {
+		var message string
+		if err := json.Unmarshal(raw, &message); err == nil {
+			details = append(details, BadRequestDetail{Message: message})
+			continue
+		}
+		var f fieldError
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return err
+		}
+		details = append(details, BadRequestDetail{Message: f.Message, Field: f.Field})
+	}
+	return newBadRequestError(details...)
 }
 
 // service is the base type for all API service providing the Client instance
@@ -0,0 +1,121 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package opml
+
+import (
+	"context"
+	"fmt"
+
+	"newreleases.io/newreleases"
+)
+
+// feedURLFormats holds release feed URL formats of providers that publish
+// them.
+var feedURLFormats = map[newreleases.Provider]string{
+	newreleases.ProviderCodeberg: "https://codeberg.org/%s/releases.rss",
+	newreleases.ProviderGitHub:   "https://github.com/%s/releases.atom",
+	newreleases.ProviderGitLab:   "https://gitlab.com/%s/-/tags?format=atom",
+}
+
+// FeedURL returns the address of the release feed published by the project
+// provider, or an empty string if the provider does not publish feeds.
+func FeedURL(p newreleases.Project) string {
+	format, ok := feedURLFormats[p.Provider]
+	if !ok || p.Name == "" {
+		return ""
+	}
+	return fmt.Sprintf(format, p.Name)
+}
+
+// ExportOptions holds optional parameters for Export.
+type ExportOptions struct {
+	// Title is the document title, "NewReleases projects" if it is not set.
+	Title string
+	// Provider limits the export to projects of the provider.
+	Provider newreleases.Provider
+	// FeedURL returns the feed address of a project. If it is nil, the
+	// FeedURL function is used. Projects without a feed address are exported
+	// with only the web address.
+	FeedURL func(p newreleases.Project) string
+}
+
+// Export lists all tracked projects and returns a document with a folder
+// outline for every tag, in the order that tags are listed, that contains
+// projects with that tag. A project with multiple tags is in multiple
+// folders. Projects without tags follow the folders.
+func Export(ctx context.Context, client *newreleases.Client, o *ExportOptions) (d *Document, err error) {
+	if o == nil {
+		o = new(ExportOptions)
+	}
+	feedURL := o.FeedURL
+	if feedURL == nil {
+		feedURL = FeedURL
+	}
+	d = &Document{
+		Version: "2.0",
+		Title:   o.Title,
+	}
+	if d.Title == "" {
+		d.Title = "NewReleases projects"
+	}
+
+	tags, err := client.Tags.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	folders := make(map[string]*Outline, len(tags))
+	for _, t := range tags {
+		folders[t.ID] = &Outline{Text: t.Name, Title: t.Name}
+	}
+
+	var untagged []Outline
+	err = client.Projects.Walk(ctx, newreleases.ProjectListOptions{
+		Order:    newreleases.ProjectListOrderName,
+		Provider: o.Provider,
+	}, func(p newreleases.Project) (stop bool, err error) {
+		outline := projectOutline(p, feedURL(p))
+		tagged := false
+		for _, id := range p.TagIDs {
+			if f, ok := folders[id]; ok {
+				f.Outlines = append(f.Outlines, outline)
+				tagged = true
+			}
+		}
+		if !tagged {
+			untagged = append(untagged, outline)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tags {
+		if f := folders[t.ID]; len(f.Outlines) > 0 {
+			d.Body = append(d.Body, *f)
+		}
+	}
+	d.Body = append(d.Body, untagged...)
+	return d, nil
+}
+
+func projectOutline(p newreleases.Project, feedURL string) Outline {
+	text := string(p.Provider) + "/" + p.Name
+	htmlURL := p.URL
+	if htmlURL == "" {
+		htmlURL, _ = newreleases.ProjectURL(p.Provider, p.Name)
+	}
+	o := Outline{
+		Text:    text,
+		Title:   text,
+		HTMLURL: htmlURL,
+	}
+	if feedURL != "" {
+		o.Type = "rss"
+		o.XMLURL = feedURL
+	}
+	return o
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package opml_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/opml"
+)
+
+func TestExport(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/tags", `{"tags": [
+		{"id": "t1", "name": "backend"},
+		{"id": "t2", "name": "frontend"},
+		{"id": "t3", "name": "empty"}
+	]}`)
+	mux.HandleFunc("/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("order"); got != "name" {
+			t.Errorf("got order %q, want %q", got, "name")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprintln(w, `{"projects": [
+				{"id": "1", "provider": "github", "name": "acme/api", "tags": ["t1"]},
+				{"id": "2", "provider": "gitlab", "name": "acme/ui", "url": "https://gitlab.com/acme/ui", "tags": ["t2"]}
+			], "total_pages": 2}`)
+		case "2":
+			fmt.Fprintln(w, `{"projects": [
+				{"id": "3", "provider": "npm", "name": "@acme/shared", "tags": ["t1", "t2", "unknown"]},
+				{"id": "4", "provider": "pypi", "name": "tool"}
+			], "total_pages": 2}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	got, err := opml.Export(context.Background(), client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shared := opml.Outline{Text: "npm/@acme/shared", Title: "npm/@acme/shared", HTMLURL: "https://www.npmjs.com/package/@acme/shared"}
+	testutil.AssertEqual(t, "", got, &opml.Document{
+		Version: "2.0",
+		Title:   "NewReleases projects",
+		Body: []opml.Outline{
+			{Text: "backend", Title: "backend", Outlines: []opml.Outline{
+				{Text: "github/acme/api", Title: "github/acme/api", Type: "rss", XMLURL: "https://github.com/acme/api/releases.atom", HTMLURL: "https://github.com/acme/api/releases"},
+				shared,
+			}},
+			{Text: "frontend", Title: "frontend", Outlines: []opml.Outline{
+				{Text: "gitlab/acme/ui", Title: "gitlab/acme/ui", Type: "rss", XMLURL: "https://gitlab.com/acme/ui/-/tags?format=atom", HTMLURL: "https://gitlab.com/acme/ui"},
+				shared,
+			}},
+			{Text: "pypi/tool", Title: "pypi/tool", HTMLURL: "https://pypi.org/project/tool/"},
+		},
+	})
+}
+
+func TestExport_feedURL(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/tags", `{"tags": []}`)
+	testutil.Handle(mux, "/v1/projects", `{"projects": [
+		{"id": "1", "provider": "pypi", "name": "tool"}
+	], "total_pages": 1}`)
+
+	got, err := opml.Export(context.Background(), client, &opml.ExportOptions{
+		Title: "Tools",
+		FeedURL: func(p newreleases.Project) string {
+			return "https://feeds.example.com/" + string(p.Provider) + "/" + p.Name
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, &opml.Document{
+		Version: "2.0",
+		Title:   "Tools",
+		Body: []opml.Outline{
+			{Text: "pypi/tool", Title: "pypi/tool", Type: "rss", XMLURL: "https://feeds.example.com/pypi/tool", HTMLURL: "https://pypi.org/project/tool/"},
+		},
+	})
+}
+
+func TestFeedURL(t *testing.T) {
+	for _, tc := range []struct {
+		provider newreleases.Provider
+		name     string
+		want     string
+	}{
+		{provider: "github", name: "acme/app", want: "https://github.com/acme/app/releases.atom"},
+		{provider: "gitlab", name: "acme/group/app", want: "https://gitlab.com/acme/group/app/-/tags?format=atom"},
+		{provider: "codeberg", name: "acme/app", want: "https://codeberg.org/acme/app/releases.rss"},
+		{provider: "npm", name: "app", want: ""},
+		{provider: "github", name: "", want: ""},
+	} {
+		got := opml.FeedURL(newreleases.Project{Provider: tc.provider, Name: tc.name})
+		testutil.AssertEqual(t, string(tc.provider)+"/"+tc.name, got, tc.want)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package opml
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/importer"
+)
+
+// Import parses an OPML document and returns references to projects of its
+// feed outlines, as returned by Document.Refs, that can be added with
+// importer.Add.
+func Import(r io.Reader) (refs []importer.Ref, unsupported []Outline, err error) {
+	d, err := Parse(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	refs, unsupported = d.Refs()
+	return refs, unsupported, nil
+}
+
+// Refs returns references to projects of outlines in all folders, without
+// duplicates. The project is recognized from the outline feed address, or from
+// its web address if the feed address is not recognized. Outlines with
+// addresses that are not recognized are returned as unsupported.
+func (d *Document) Refs() (refs []importer.Ref, unsupported []Outline) {
+	refs, unsupported = outlineRefs(d.Body, nil, nil)
+	return importer.Unique(refs), unsupported
+}
+
+func outlineRefs(outlines []Outline, refs []importer.Ref, unsupported []Outline) ([]importer.Ref, []Outline) {
+	for _, o := range outlines {
+		if len(o.Outlines) > 0 {
+			refs, unsupported = outlineRefs(o.Outlines, refs, unsupported)
+		}
+		if o.XMLURL == "" && o.HTMLURL == "" {
+			continue
+		}
+		provider, name, err := ParseFeedURL(o.XMLURL)
+		if err != nil {
+			provider, name, err = newreleases.ParseProjectURL(o.HTMLURL)
+		}
+		if err != nil {
+			unsupported = append(unsupported, Outline{
+				Text:    o.Text,
+				Title:   o.Title,
+				Type:    o.Type,
+				XMLURL:  o.XMLURL,
+				HTMLURL: o.HTMLURL,
+			})
+			continue
+		}
+		refs = append(refs, importer.Ref{Provider: provider, Name: name})
+	}
+	return refs, unsupported
+}
+
+// ParseFeedURL returns the provider and the project name from an address of a
+// project release or tag feed, such as
+// https://github.com/foo/bar/releases.atom,
+// https://gitlab.com/foo/bar/-/tags?format=atom or
+// https://registry.npmjs.org/@foo/bar. Web addresses of project pages that
+// newreleases.ParseProjectURL recognizes are also accepted.
+func ParseFeedURL(rawURL string) (provider newreleases.Provider, name string, err error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return "", "", fmt.Errorf("empty url: %w", newreleases.ErrProjectURLUnsupported)
+	}
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.") {
+	case "registry.npmjs.org":
+		// Package documents of the npm registry, which feed services use to
+		// generate release feeds.
+		p, err := url.PathUnescape(strings.Trim(u.EscapedPath(), "/"))
+		if err != nil {
+			return "", "", err
+		}
+		s := strings.Split(p, "/")
+		switch {
+		case len(s) >= 2 && strings.HasPrefix(s[0], "@") && len(s[0]) > 1 && s[1] != "":
+			return newreleases.ProviderNPM, s[0] + "/" + s[1], nil
+		case s[0] != "" && !strings.HasPrefix(s[0], "@") && !strings.HasPrefix(s[0], "-"):
+			return newreleases.ProviderNPM, s[0], nil
+		}
+		return "", "", fmt.Errorf("%s: %w", rawURL, newreleases.ErrProjectURLUnsupported)
+	case "gitlab.com":
+		// Feeds of older GitLab versions do not have the dash path segment
+		// that separates the project path from the page.
+		if !strings.Contains(u.Path, "/-/") {
+			for _, suffix := range []string{"/tags.atom", "/tags", "/releases.atom", "/releases"} {
+				if strings.HasSuffix(u.Path, suffix) {
+					u.Path = strings.TrimSuffix(u.Path, suffix)
+					u.RawPath = ""
+					break
+				}
+			}
+		}
+	}
+	return newreleases.ParseProjectURL(u.String())
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package opml_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/importer"
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/opml"
+)
+
+func TestImport(t *testing.T) {
+	refs, unsupported, err := opml.Import(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<opml version="1.0">
+  <head><title>Subscriptions</title></head>
+  <body>
+    <outline text="Releases">
+      <outline text="api" type="rss" xmlUrl="https://github.com/acme/api/releases.atom" htmlUrl="https://github.com/acme/api"/>
+      <outline text="ui" type="rss" xmlUrl="https://gitlab.com/acme/group/ui/-/tags?format=atom"/>
+      <outline text="legacy" type="rss" xmlUrl="https://gitlab.com/acme/legacy/tags.atom"/>
+      <outline text="nested">
+        <outline text="shared" type="rss" xmlUrl="https://registry.npmjs.org/@acme%2fshared"/>
+        <outline text="api again" type="rss" xmlUrl="https://github.com/acme/api/tags.atom"/>
+      </outline>
+    </outline>
+    <outline text="tool" type="rss" xmlUrl="https://feeds.example.com/pypi/tool" htmlUrl="https://pypi.org/project/tool/"/>
+    <outline text="Blog" type="rss" xmlUrl="https://blog.example.com/feed.xml" htmlUrl="https://blog.example.com/"/>
+    <outline text="Separator"/>
+  </body>
+</opml>
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "refs", refs, []importer.Ref{
+		{Provider: "github", Name: "acme/api"},
+		{Provider: "gitlab", Name: "acme/group/ui"},
+		{Provider: "gitlab", Name: "acme/legacy"},
+		{Provider: "npm", Name: "@acme/shared"},
+		{Provider: "pypi", Name: "tool"},
+	})
+	testutil.AssertEqual(t, "unsupported", unsupported, []opml.Outline{
+		{Text: "Blog", Type: "rss", XMLURL: "https://blog.example.com/feed.xml", HTMLURL: "https://blog.example.com/"},
+	})
+}
+
+func TestImport_invalid(t *testing.T) {
+	if _, _, err := opml.Import(strings.NewReader("<opml><body>")); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestParseFeedURL(t *testing.T) {
+	for _, tc := range []struct {
+		url      string
+		provider newreleases.Provider
+		name     string
+	}{
+		{url: "https://github.com/acme/app/releases.atom", provider: "github", name: "acme/app"},
+		{url: "github.com/acme/app/tags.atom", provider: "github", name: "acme/app"},
+		{url: "https://gitlab.com/acme/app/-/tags?format=atom", provider: "gitlab", name: "acme/app"},
+		{url: "https://gitlab.com/acme/group/app/-/releases.atom", provider: "gitlab", name: "acme/group/app"},
+		{url: "https://gitlab.com/acme/app/tags?format=atom", provider: "gitlab", name: "acme/app"},
+		{url: "https://codeberg.org/acme/app/releases.rss", provider: "codeberg", name: "acme/app"},
+		{url: "https://registry.npmjs.org/app", provider: "npm", name: "app"},
+		{url: "https://registry.npmjs.org/@acme/app", provider: "npm", name: "@acme/app"},
+		{url: "https://registry.npmjs.org/@acme%2Fapp/", provider: "npm", name: "@acme/app"},
+		{url: "https://www.npmjs.com/package/app", provider: "npm", name: "app"},
+	} {
+		provider, name, err := opml.ParseFeedURL(tc.url)
+		if err != nil {
+			t.Errorf("%s: %v", tc.url, err)
+			continue
+		}
+		testutil.AssertEqual(t, tc.url+" provider", provider, tc.provider)
+		testutil.AssertEqual(t, tc.url+" name", name, tc.name)
+	}
+
+	for _, u := range []string{
+		"",
+		"https://registry.npmjs.org/",
+		"https://registry.npmjs.org/@acme",
+		"https://registry.npmjs.org/-/v1/search",
+		"https://blog.example.com/feed.xml",
+	} {
+		if _, _, err := opml.ParseFeedURL(u); !errors.Is(err, newreleases.ErrProjectURLUnsupported) {
+			t.Errorf("%q: got error %v, want %v", u, err, newreleases.ErrProjectURLUnsupported)
+		}
+	}
+}
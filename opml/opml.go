@@ -0,0 +1,56 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package opml exports tracked projects as OPML documents that feed readers
+// can subscribe to, and imports projects from OPML documents with release
+// feeds of GitHub, GitLab, Codeberg and npm projects.
+package opml // import "newreleases.io/newreleases/opml"
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// Document is an OPML 2.0 document.
+type Document struct {
+	XMLName xml.Name  `xml:"opml"`
+	Version string    `xml:"version,attr"`
+	Title   string    `xml:"head>title,omitempty"`
+	Body    []Outline `xml:"body>outline"`
+}
+
+// Outline is an OPML outline element. Outlines with child outlines are
+// folders, and outlines with XMLURL are feed subscriptions.
+type Outline struct {
+	Text     string    `xml:"text,attr"`
+	Title    string    `xml:"title,attr,omitempty"`
+	Type     string    `xml:"type,attr,omitempty"`
+	XMLURL   string    `xml:"xmlUrl,attr,omitempty"`
+	HTMLURL  string    `xml:"htmlUrl,attr,omitempty"`
+	Outlines []Outline `xml:"outline"`
+}
+
+// Parse decodes an OPML document. Versions 1.0 and 2.0 are supported.
+func Parse(r io.Reader) (d *Document, err error) {
+	d = new(Document)
+	if err := xml.NewDecoder(r).Decode(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Write encodes the document as indented XML.
+func (d *Document) Write(w io.Writer) (err error) {
+	if _, err = io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err = enc.Encode(d); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
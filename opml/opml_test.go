@@ -0,0 +1,52 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package opml_test
+
+import (
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/opml"
+)
+
+func TestDocument_Write(t *testing.T) {
+	d := &opml.Document{
+		Version: "2.0",
+		Title:   "Projects & more",
+		Body: []opml.Outline{
+			{Text: "backend", Title: "backend", Outlines: []opml.Outline{
+				{Text: "github/acme/api", Title: "github/acme/api", Type: "rss", XMLURL: "https://github.com/acme/api/releases.atom", HTMLURL: "https://github.com/acme/api/releases"},
+			}},
+			{Text: "gitlab/acme/ui", Title: "gitlab/acme/ui", Type: "rss", XMLURL: "https://gitlab.com/acme/ui/-/tags?format=atom&x=1"},
+		},
+	}
+	var b strings.Builder
+	if err := d.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head>
+    <title>Projects &amp; more</title>
+  </head>
+  <body>
+    <outline text="backend" title="backend">
+      <outline text="github/acme/api" title="github/acme/api" type="rss" xmlUrl="https://github.com/acme/api/releases.atom" htmlUrl="https://github.com/acme/api/releases"></outline>
+    </outline>
+    <outline text="gitlab/acme/ui" title="gitlab/acme/ui" type="rss" xmlUrl="https://gitlab.com/acme/ui/-/tags?format=atom&amp;x=1"></outline>
+  </body>
+</opml>
+`
+	testutil.AssertEqual(t, "", b.String(), want)
+
+	got, err := opml.Parse(strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.XMLName = d.XMLName
+	testutil.AssertEqual(t, "parsed", got, d)
+}
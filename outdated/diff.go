@@ -0,0 +1,118 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package outdated
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines around changes in a diff.
+const diffContext = 3
+
+// Apply returns the go.mod file data with require lines edited by the
+// upgrades. Upgrades must be for requirements parsed from the same data.
+func Apply(data []byte, upgrades []Upgrade) (updated []byte, err error) {
+	lines, _, err := apply(data, upgrades)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// Diff returns a unified diff of the go.mod file data edited by the upgrades.
+// The filename is used in the diff header.
+func Diff(filename string, data []byte, upgrades []Upgrade) (diff string, err error) {
+	updated, changed, err := apply(data, upgrades)
+	if err != nil {
+		return "", err
+	}
+	if len(changed) == 0 {
+		return "", nil
+	}
+	original := strings.Split(string(data), "\n")
+	n := len(original)
+	if strings.HasSuffix(string(data), "\n") {
+		n--
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- a/%s\n+++ b/%s\n", filename, filename)
+	for i := 0; i < len(changed); {
+		// Group changes that are close enough to share context lines.
+		j := i + 1
+		for j < len(changed) && changed[j]-changed[j-1] <= 2*diffContext {
+			j++
+		}
+		start := changed[i] - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := changed[j-1] + diffContext + 1
+		if end > n {
+			end = n
+		}
+		fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", start+1, end-start, start+1, end-start)
+
+		isChanged := make(map[int]bool, j-i)
+		for _, k := range changed[i:j] {
+			isChanged[k] = true
+		}
+		for k := start; k < end; {
+			if !isChanged[k] {
+				b.WriteString(" " + original[k] + "\n")
+				k++
+				continue
+			}
+			// Write consecutive changed lines as removals followed by
+			// additions.
+			l := k
+			for l < end && isChanged[l] {
+				l++
+			}
+			for m := k; m < l; m++ {
+				b.WriteString("-" + original[m] + "\n")
+			}
+			for m := k; m < l; m++ {
+				b.WriteString("+" + updated[m] + "\n")
+			}
+			k = l
+		}
+		i = j
+	}
+	return b.String(), nil
+}
+
+// apply edits require lines and returns all lines and sorted indexes of the
+// changed ones.
+func apply(data []byte, upgrades []Upgrade) (lines []string, changed []int, err error) {
+	lines = strings.Split(string(data), "\n")
+	seen := make(map[int]bool, len(upgrades))
+	for _, u := range upgrades {
+		i := u.Line - 1
+		if i < 0 || i >= len(lines) {
+			return nil, nil, fmt.Errorf("%s: line %v not found", u.Path, u.Line)
+		}
+		line := lines[i]
+		p := strings.Index(line, u.Path)
+		if p < 0 {
+			return nil, nil, fmt.Errorf("%s: not required on line %v", u.Path, u.Line)
+		}
+		v := strings.Index(line[p+len(u.Path):], u.Version)
+		if v < 0 {
+			return nil, nil, fmt.Errorf("%s: version %s not required on line %v", u.Path, u.Version, u.Line)
+		}
+		v += p + len(u.Path)
+		lines[i] = line[:p] + u.NewPath + line[p+len(u.Path):v] + u.NewVersion + line[v+len(u.Version):]
+		if !seen[i] {
+			seen[i] = true
+			changed = append(changed, i)
+		}
+	}
+	sort.Ints(changed)
+	return lines, changed, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package outdated_test
+
+import (
+	"bytes"
+	"testing"
+
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/outdated"
+)
+
+const diffGoMod = `module example.com/service
+
+go 1.21
+
+require (
+	github.com/spf13/cobra v1.7.0
+	go.uber.org/zap v1.24.0
+	golang.org/x/sync v0.3.0 // indirect
+)
+
+require (
+	github.com/a/a v1.0.0
+	github.com/b/b v1.0.0
+	github.com/c/c v1.0.0
+	github.com/d/d v1.0.0
+	github.com/e/e v1.0.0
+	github.com/f/f v1.0.0
+	github.com/g/g v1.0.0
+	github.com/h/h v1.0.0
+)
+`
+
+func TestDiff(t *testing.T) {
+	m, err := outdated.ParseGoMod(bytes.NewReader([]byte(diffGoMod)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	upgrades := []outdated.Upgrade{
+		{Module: m.Requires[0], NewPath: "github.com/spf13/cobra/v2", NewVersion: "v2.0.0"},
+		{Module: m.Requires[2], NewPath: "golang.org/x/sync", NewVersion: "v0.4.0"},
+		{Module: m.Requires[1], NewPath: "go.uber.org/zap", NewVersion: "v1.26.0"},
+		{Module: m.Requires[10], NewPath: "github.com/h/h", NewVersion: "v1.1.0"},
+	}
+
+	got, err := outdated.Diff("go.mod", []byte(diffGoMod), upgrades)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", got, `--- a/go.mod
++++ b/go.mod
+@@ -3,9 +3,9 @@
+ go 1.21
+ 
+ require (
+-	github.com/spf13/cobra v1.7.0
+-	go.uber.org/zap v1.24.0
+-	golang.org/x/sync v0.3.0 // indirect
++	github.com/spf13/cobra/v2 v2.0.0
++	go.uber.org/zap v1.26.0
++	golang.org/x/sync v0.4.0 // indirect
+ )
+ 
+ require (
+@@ -16,5 +16,5 @@
+ 	github.com/e/e v1.0.0
+ 	github.com/f/f v1.0.0
+ 	github.com/g/g v1.0.0
+-	github.com/h/h v1.0.0
++	github.com/h/h v1.1.0
+ )
+`)
+
+	updated, err := outdated.Apply([]byte(diffGoMod), upgrades[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "apply", string(updated), `module example.com/service
+
+go 1.21
+
+require (
+	github.com/spf13/cobra/v2 v2.0.0
+`+diffGoMod[len("module example.com/service\n\ngo 1.21\n\nrequire (\n\tgithub.com/spf13/cobra v1.7.0\n"):])
+}
+
+func TestDiff_mismatch(t *testing.T) {
+	_, err := outdated.Diff("go.mod", []byte(diffGoMod), []outdated.Upgrade{
+		{Module: outdated.Module{Path: "github.com/spf13/cobra", Version: "v1.6.0", Line: 6}, NewPath: "github.com/spf13/cobra", NewVersion: "v1.8.0"},
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
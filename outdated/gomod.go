@@ -0,0 +1,117 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package outdated
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Module is a module requirement from a go.mod file.
+type Module struct {
+	Path     string
+	Version  string
+	Indirect bool
+	// Line is the number of the go.mod line with the requirement.
+	Line int
+}
+
+// GoMod holds requirements from a go.mod file.
+type GoMod struct {
+	// Module is the path of the module that the go.mod file declares.
+	Module   string
+	Requires []Module
+}
+
+// ParseGoModFile parses a go.mod file.
+func ParseGoModFile(filename string) (m *GoMod, err error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m, err = ParseGoMod(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return m, nil
+}
+
+// ParseGoMod parses the module path and requirements from a go.mod file.
+// Other directives are ignored.
+func ParseGoMod(r io.Reader) (m *GoMod, err error) {
+	m = new(GoMod)
+	scanner := bufio.NewScanner(r)
+	inRequire := false
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		comment := ""
+		if i := strings.Index(line, "//"); i >= 0 {
+			line, comment = strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+2:])
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if inRequire {
+			if fields[0] == ")" {
+				inRequire = false
+				continue
+			}
+		} else {
+			switch fields[0] {
+			case "module":
+				if len(fields) != 2 {
+					return nil, fmt.Errorf("line %v: invalid module directive", n)
+				}
+				m.Module, err = unquote(fields[1])
+				if err != nil {
+					return nil, fmt.Errorf("line %v: %w", n, err)
+				}
+				continue
+			case "require":
+				if len(fields) == 2 && fields[1] == "(" {
+					inRequire = true
+					continue
+				}
+				fields = fields[1:]
+			default:
+				continue
+			}
+		}
+
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %v: invalid requirement", n)
+		}
+		path, err := unquote(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %w", n, err)
+		}
+		m.Requires = append(m.Requires, Module{
+			Path:     path,
+			Version:  fields[1],
+			Indirect: comment == "indirect" || strings.HasPrefix(comment, "indirect;"),
+			Line:     n,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func unquote(s string) (string, error) {
+	if strings.HasPrefix(s, `"`) || strings.HasPrefix(s, "`") {
+		return strconv.Unquote(s)
+	}
+	return s, nil
+}
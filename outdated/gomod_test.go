@@ -0,0 +1,54 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package outdated_test
+
+import (
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/outdated"
+)
+
+func TestParseGoMod(t *testing.T) {
+	m, err := outdated.ParseGoMod(strings.NewReader(`module example.com/service
+
+go 1.21
+
+require github.com/spf13/cobra v1.7.0
+
+require (
+	// logging
+	go.uber.org/zap v1.24.0
+	"golang.org/x/sync" v0.3.0 // indirect
+	github.com/docker/docker v24.0.2+incompatible // indirect; needed by tests
+)
+
+replace github.com/spf13/cobra => ../cobra
+
+exclude golang.org/x/sync v0.2.0
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "", m, &outdated.GoMod{
+		Module: "example.com/service",
+		Requires: []outdated.Module{
+			{Path: "github.com/spf13/cobra", Version: "v1.7.0", Line: 5},
+			{Path: "go.uber.org/zap", Version: "v1.24.0", Line: 9},
+			{Path: "golang.org/x/sync", Version: "v0.3.0", Indirect: true, Line: 10},
+			{Path: "github.com/docker/docker", Version: "v24.0.2+incompatible", Indirect: true, Line: 11},
+		},
+	})
+}
+
+func TestParseGoMod_invalid(t *testing.T) {
+	_, err := outdated.ParseGoMod(strings.NewReader("module example.com/service\n\nrequire (\n\tgo.uber.org/zap\n)\n"))
+	if err == nil || err.Error() != "line 4: invalid requirement" {
+		t.Fatalf("got error %v", err)
+	}
+}
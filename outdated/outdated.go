@@ -0,0 +1,223 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package outdated checks Go module requirements against the latest releases
+// of projects tracked on NewReleases and suggests upgrades as go get commands
+// or go.mod file diffs.
+package outdated // import "newreleases.io/newreleases/outdated"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/version"
+)
+
+// Lag is the difference between the required and the latest version.
+type Lag int
+
+// Lags ordered from the smallest to the largest. LagUnknown is used when
+// versions can not be compared.
+const (
+	LagUnknown Lag = iota
+	LagNone
+	LagPatch
+	LagMinor
+	LagMajor
+)
+
+func (l Lag) String() string {
+	switch l {
+	case LagNone:
+		return "none"
+	case LagPatch:
+		return "patch"
+	case LagMinor:
+		return "minor"
+	case LagMajor:
+		return "major"
+	}
+	return "unknown"
+}
+
+// ErrInvalidLag is returned by ParseLag for unknown lag names.
+var ErrInvalidLag = errors.New("invalid lag")
+
+// ParseLag returns the lag for its name, one of none, patch, minor or major.
+func ParseLag(s string) (l Lag, err error) {
+	for _, l := range []Lag{LagNone, LagPatch, LagMinor, LagMajor} {
+		if strings.EqualFold(s, l.String()) {
+			return l, nil
+		}
+	}
+	return LagUnknown, fmt.Errorf("%w %q", ErrInvalidLag, s)
+}
+
+// Result holds the latest release of a required module.
+type Result struct {
+	Module
+	// Provider and Project reference the tracked project that the module
+	// is resolved to.
+	Provider newreleases.Provider
+	Project  string
+	// Latest is the latest non-excluded release of the project.
+	Latest newreleases.Release
+	Lag    Lag
+}
+
+// Report holds results of checking go.mod requirements.
+type Report struct {
+	Results []Result
+	// Untracked holds modules that are not tracked on NewReleases.
+	Untracked []Module
+}
+
+// Violations returns results with the lag equal to or larger than failOn.
+// No results are returned if failOn is LagNone or LagUnknown.
+func (r *Report) Violations(failOn Lag) (results []Result) {
+	if failOn <= LagNone {
+		return nil
+	}
+	for _, result := range r.Results {
+		if result.Lag >= failOn {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// Options holds optional parameters for Check.
+type Options struct {
+	// Indirect includes indirect requirements.
+	Indirect bool
+}
+
+// CheckFile parses a go.mod file and checks its requirements.
+func CheckFile(ctx context.Context, client *newreleases.Client, filename string, o *Options) (r *Report, err error) {
+	m, err := ParseGoModFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return Check(ctx, client, m.Requires, o)
+}
+
+// Check looks up tracked projects for modules and compares the required
+// versions with the versions of the latest project releases.
+func Check(ctx context.Context, client *newreleases.Client, modules []Module, o *Options) (r *Report, err error) {
+	if o == nil {
+		o = new(Options)
+	}
+	r = new(Report)
+	for _, m := range modules {
+		if m.Indirect && !o.Indirect {
+			continue
+		}
+		result, ok, err := check(ctx, client, m)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", m.Path, err)
+		}
+		if !ok {
+			r.Untracked = append(r.Untracked, m)
+			continue
+		}
+		r.Results = append(r.Results, result)
+	}
+	return r, nil
+}
+
+func check(ctx context.Context, client *newreleases.Client, m Module) (result Result, ok bool, err error) {
+	project, latest, err := resolve(ctx, client, m.Path)
+	if err != nil || project == nil {
+		return Result{}, false, err
+	}
+	return Result{
+		Module:   m,
+		Provider: project.Provider,
+		Project:  project.Name,
+		Latest:   *latest,
+		Lag:      Compare(m.Version, latest.Version),
+	}, true, nil
+}
+
+// resolve returns the first tracked project with releases from the projects
+// that the module may be tracked as, and its latest release. It returns nil
+// project if none is found.
+func resolve(ctx context.Context, client *newreleases.Client, modulePath string) (project *newreleases.Project, latest *newreleases.Release, err error) {
+	for _, p := range Projects(modulePath) {
+		project, err := client.Projects.Get(ctx, p)
+		if errors.Is(err, newreleases.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		latest, err := client.Releases.GetLatest(ctx, project.Ref())
+		if errors.Is(err, newreleases.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return project, latest, nil
+	}
+	return nil, nil, nil
+}
+
+// Projects returns references to projects that a module may be tracked as,
+// in the order that they should be looked up. A module is tracked by its path
+// with the Go provider, and modules hosted on GitHub, GitLab or Codeberg are
+// also tracked as their repositories.
+func Projects(modulePath string) (refs []newreleases.ProjectRef) {
+	refs = append(refs, newreleases.ProjectRefByName(newreleases.ProviderGo, modulePath))
+	parts := strings.Split(modulePath, "/")
+	if len(parts) < 3 {
+		return refs
+	}
+	var provider newreleases.Provider
+	switch parts[0] {
+	case "github.com":
+		provider = newreleases.ProviderGitHub
+	case "gitlab.com":
+		provider = newreleases.ProviderGitLab
+	case "codeberg.org":
+		provider = newreleases.ProviderCodeberg
+	default:
+		return refs
+	}
+	return append(refs, newreleases.ProjectRefByName(provider, parts[1]+"/"+parts[2]))
+}
+
+// Compare returns the lag of the current version behind the latest one. Both
+// are compared as Go module versions, so pseudo-versions are ordered as
+// prereleases of the following version.
+func Compare(current, latest string) Lag {
+	c, err := ParseVersion(current)
+	if err != nil {
+		return LagUnknown
+	}
+	l, err := ParseVersion(latest)
+	if err != nil {
+		return LagUnknown
+	}
+	switch {
+	case !c.LessThan(l):
+		return LagNone
+	case c.Major() != l.Major():
+		return LagMajor
+	case c.Minor() != l.Minor():
+		return LagMinor
+	}
+	return LagPatch
+}
+
+// ParseVersion parses a Go module version. Module versions are semantic
+// versions, so the timestamp and commit of a pseudo-version are a prerelease,
+// and +incompatible is build metadata.
+func ParseVersion(s string) (v version.Version, err error) {
+	return version.Parse(s)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package outdated_test
+
+import (
+	"context"
+	"testing"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/outdated"
+)
+
+func TestCompare(t *testing.T) {
+	for _, tc := range []struct {
+		current, latest string
+		want            outdated.Lag
+	}{
+		{current: "v1.2.3", latest: "v1.2.3", want: outdated.LagNone},
+		{current: "v1.2.4", latest: "v1.2.3", want: outdated.LagNone},
+		{current: "v1.2.3", latest: "v1.2.4", want: outdated.LagPatch},
+		{current: "v1.2.3", latest: "1.3.0", want: outdated.LagMinor},
+		{current: "v1.2.3", latest: "v2.0.0", want: outdated.LagMajor},
+		{current: "v1.2.4-0.20230601120000-abcdef123456", latest: "v1.2.4", want: outdated.LagPatch},
+		{current: "v0.0.0-20230601120000-abcdef123456", latest: "v0.1.0", want: outdated.LagMinor},
+		{current: "v24.0.2+incompatible", latest: "v24.0.2", want: outdated.LagNone},
+		{current: "v1.2.3", latest: "nightly", want: outdated.LagUnknown},
+	} {
+		if got := outdated.Compare(tc.current, tc.latest); got != tc.want {
+			t.Errorf("%s to %s: got %v, want %v", tc.current, tc.latest, got, tc.want)
+		}
+	}
+}
+
+func TestParseLag(t *testing.T) {
+	got, err := outdated.ParseLag("Minor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "", got, outdated.LagMinor)
+
+	if _, err := outdated.ParseLag("huge"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestProjects(t *testing.T) {
+	testutil.AssertEqual(t, "github", outdated.Projects("github.com/spf13/cobra/v2"), []newreleases.ProjectRef{
+		newreleases.ProjectRefByName(newreleases.ProviderGo, "github.com/spf13/cobra/v2"),
+		newreleases.ProjectRefByName(newreleases.ProviderGitHub, "spf13/cobra"),
+	})
+	testutil.AssertEqual(t, "vanity", outdated.Projects("go.uber.org/zap"), []newreleases.ProjectRef{
+		newreleases.ProjectRefByName(newreleases.ProviderGo, "go.uber.org/zap"),
+	})
+}
+
+func TestCheck(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/projects/go/go.uber.org/zap", `{"id": "zap", "provider": "go", "name": "go.uber.org/zap"}`)
+	testutil.Handle(mux, "/v1/projects/zap/latest-release", `{"version": "v1.26.0"}`)
+	testutil.Handle(mux, "/v1/projects/github/spf13/cobra", `{"id": "cobra", "provider": "github", "name": "spf13/cobra"}`)
+	testutil.Handle(mux, "/v1/projects/cobra/latest-release", `{"version": "v1.7.1"}`)
+	testutil.Handle(mux, "/v1/projects/go/golang.org/x/sync", `{"id": "sync", "provider": "go", "name": "golang.org/x/sync"}`)
+	testutil.Handle(mux, "/v1/projects/sync/latest-release", `{"version": "v0.3.0"}`)
+
+	modules := []outdated.Module{
+		{Path: "github.com/spf13/cobra", Version: "v1.7.0"},
+		{Path: "go.uber.org/zap", Version: "v1.24.0"},
+		{Path: "example.com/private", Version: "v1.0.0"},
+		{Path: "golang.org/x/sync", Version: "v0.3.0", Indirect: true},
+	}
+
+	report, err := outdated.Check(context.Background(), client, modules, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "report", report, &outdated.Report{
+		Results: []outdated.Result{
+			{
+				Module:   modules[0],
+				Provider: "github",
+				Project:  "spf13/cobra",
+				Latest:   newreleases.Release{Version: "v1.7.1"},
+				Lag:      outdated.LagPatch,
+			},
+			{
+				Module:   modules[1],
+				Provider: "go",
+				Project:  "go.uber.org/zap",
+				Latest:   newreleases.Release{Version: "v1.26.0"},
+				Lag:      outdated.LagMinor,
+			},
+		},
+		Untracked: []outdated.Module{modules[2]},
+	})
+	testutil.AssertEqual(t, "minor violations", len(report.Violations(outdated.LagMinor)), 1)
+	testutil.AssertEqual(t, "patch violations", len(report.Violations(outdated.LagPatch)), 2)
+	testutil.AssertEqual(t, "no violations", len(report.Violations(outdated.LagNone)), 0)
+
+	report, err = outdated.Check(context.Background(), client, modules, &outdated.Options{Indirect: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "indirect", report.Results[2].Lag, outdated.LagNone)
+}
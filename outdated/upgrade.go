@@ -0,0 +1,190 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package outdated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/version"
+)
+
+// Upgrade is a suggested change of a module requirement.
+type Upgrade struct {
+	Module
+	// NewPath is the module path to require, which differs from the current
+	// path on major version upgrades of modules with /vN path suffixes.
+	NewPath string
+	// NewVersion is the module version to require.
+	NewVersion string
+	Lag        Lag
+	// Warning describes changes that are needed in addition to the go.mod
+	// update, like updating import paths after a module path change.
+	Warning string
+}
+
+// UpgradeOptions holds optional parameters for Upgrades.
+type UpgradeOptions struct {
+	// Level is the largest allowed upgrade: LagPatch allows only patch
+	// upgrades within the current minor version, LagMinor allows upgrades
+	// within the current major version and LagMajor allows all upgrades.
+	// LagMinor is used if it is not set.
+	Level Lag
+	// Indirect includes indirect requirements.
+	Indirect bool
+	// MaxPages limits the number of listed release pages for every module.
+	// All pages are listed if it is zero.
+	MaxPages int
+}
+
+// UpgradesFile parses a go.mod file and returns upgrades for its
+// requirements.
+func UpgradesFile(ctx context.Context, client *newreleases.Client, filename string, o *UpgradeOptions) (upgrades []Upgrade, err error) {
+	m, err := ParseGoModFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return Upgrades(ctx, client, m.Requires, o)
+}
+
+// Upgrades returns upgrades to the highest released versions allowed by the
+// upgrade level for modules that are tracked on NewReleases. Modules that are
+// not tracked or are up to date are skipped.
+func Upgrades(ctx context.Context, client *newreleases.Client, modules []Module, o *UpgradeOptions) (upgrades []Upgrade, err error) {
+	if o == nil {
+		o = new(UpgradeOptions)
+	}
+	level := o.Level
+	if level <= LagNone {
+		level = LagMinor
+	}
+	for _, m := range modules {
+		if m.Indirect && !o.Indirect {
+			continue
+		}
+		u, ok, err := upgrade(ctx, client, m, level, o.MaxPages)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", m.Path, err)
+		}
+		if ok {
+			upgrades = append(upgrades, u)
+		}
+	}
+	return upgrades, nil
+}
+
+func upgrade(ctx context.Context, client *newreleases.Client, m Module, level Lag, maxPages int) (u Upgrade, ok bool, err error) {
+	current, err := ParseVersion(m.Version)
+	if err != nil {
+		return Upgrade{}, false, nil
+	}
+	project, _, err := resolve(ctx, client, m.Path)
+	if err != nil || project == nil {
+		return Upgrade{}, false, err
+	}
+
+	var constraint *version.Constraint
+	switch level {
+	case LagPatch:
+		constraint, err = version.ParseConstraint(fmt.Sprintf("~%d.%d", current.Major(), current.Minor()))
+	case LagMinor:
+		constraint, err = version.ParseConstraint(strconv.Itoa(current.Major()))
+	}
+	if err != nil {
+		return Upgrade{}, false, err
+	}
+	release, err := client.Releases.GetLatestMatching(ctx, project.Ref(), constraint, &newreleases.LatestMatchingOptions{
+		SkipExcluded: true,
+		MaxPages:     maxPages,
+	})
+	if errors.Is(err, newreleases.ErrNoMatchingRelease) {
+		return Upgrade{}, false, nil
+	}
+	if err != nil {
+		return Upgrade{}, false, err
+	}
+
+	target, ok := moduleVersion(release.Version)
+	if !ok {
+		return Upgrade{}, false, nil
+	}
+	lag := Compare(m.Version, target)
+	if lag <= LagNone {
+		return Upgrade{}, false, nil
+	}
+
+	u = Upgrade{
+		Module:     m,
+		NewPath:    m.Path,
+		NewVersion: target,
+		Lag:        lag,
+	}
+	if lag == LagMajor {
+		u.NewPath, u.NewVersion, u.Warning = majorUpgrade(m, target)
+	}
+	return u, true, nil
+}
+
+// moduleVersion returns a Go module version for a release version, adding
+// the v prefix if it is missing. Versions without exactly three numeric
+// segments are not valid module versions.
+func moduleVersion(s string) (v string, ok bool) {
+	s = "v" + strings.TrimPrefix(s, "v")
+	if len(s) < 2 || s[1] < '0' || s[1] > '9' {
+		return "", false
+	}
+	parsed, err := ParseVersion(s)
+	if err != nil || len(parsed.Segments) != 3 || parsed.Metadata != "" {
+		return "", false
+	}
+	if parsed.Prerelease != "" && !strings.Contains(s, "-"+parsed.Prerelease) {
+		return "", false
+	}
+	return s, true
+}
+
+// majorUpgrade returns the module path and version for an upgrade to a new
+// major version, together with a warning about the needed changes.
+func majorUpgrade(m Module, target string) (path, v, warning string) {
+	if strings.HasSuffix(m.Version, "+incompatible") {
+		return m.Path, target + "+incompatible", fmt.Sprintf("%s is required without a go.mod file, %s may require a module path change", m.Path, target)
+	}
+	parsed, _ := ParseVersion(target)
+	major := parsed.Major()
+
+	path = m.Path
+	if strings.HasPrefix(path, "gopkg.in/") {
+		// Major versions of gopkg.in modules are in .vN path suffixes.
+		if i := strings.LastIndex(path, ".v"); i > 0 {
+			path = path[:i] + ".v" + strconv.Itoa(major)
+		}
+	} else {
+		if i := strings.LastIndex(path, "/v"); i > 0 {
+			if _, err := strconv.Atoi(path[i+2:]); err == nil {
+				path = path[:i]
+			}
+		}
+		if major >= 2 {
+			path += "/v" + strconv.Itoa(major)
+		}
+	}
+	if path == m.Path {
+		return path, target, ""
+	}
+	return path, target, fmt.Sprintf("module path changes from %s to %s, import paths must be updated", m.Path, path)
+}
+
+// GoGetCommands returns go get commands that apply the upgrades.
+func GoGetCommands(upgrades []Upgrade) (commands []string) {
+	for _, u := range upgrades {
+		commands = append(commands, "go get "+u.NewPath+"@"+u.NewVersion)
+	}
+	return commands
+}
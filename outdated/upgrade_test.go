@@ -0,0 +1,119 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package outdated_test
+
+import (
+	"context"
+	"testing"
+
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/outdated"
+)
+
+func TestUpgrades(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/projects/github/spf13/cobra", `{"id": "cobra", "provider": "github", "name": "spf13/cobra"}`)
+	testutil.Handle(mux, "/v1/projects/cobra/latest-release", `{"version": "v2.0.0"}`)
+	testutil.Handle(mux, "/v1/projects/cobra/releases", `{"releases": [
+		{"version": "v2.1.0-rc.1", "is_prerelease": true},
+		{"version": "v2.0.0"},
+		{"version": "v1.8.0"},
+		{"version": "v1.7.2", "is_excluded": true},
+		{"version": "v1.7.1"},
+		{"version": "v1.7.0"}
+	], "total_pages": 1}`)
+	testutil.Handle(mux, "/v1/projects/go/gopkg.in/yaml.v2", `{"id": "yaml", "provider": "go", "name": "gopkg.in/yaml.v2"}`)
+	testutil.Handle(mux, "/v1/projects/yaml/latest-release", `{"version": "3.0.1"}`)
+	testutil.Handle(mux, "/v1/projects/yaml/releases", `{"releases": [
+		{"version": "3.0.1"},
+		{"version": "2.4.0"}
+	], "total_pages": 1}`)
+	testutil.Handle(mux, "/v1/projects/go/github.com/docker/docker", `{"id": "docker", "provider": "go", "name": "github.com/docker/docker"}`)
+	testutil.Handle(mux, "/v1/projects/docker/latest-release", `{"version": "v25.0.0"}`)
+	testutil.Handle(mux, "/v1/projects/docker/releases", `{"releases": [
+		{"version": "v25.0.0"},
+		{"version": "v24.0.7"}
+	], "total_pages": 1}`)
+
+	modules := []outdated.Module{
+		{Path: "github.com/spf13/cobra", Version: "v1.7.0", Line: 5},
+		{Path: "gopkg.in/yaml.v2", Version: "v2.4.0", Line: 6},
+		{Path: "github.com/docker/docker", Version: "v24.0.2+incompatible", Line: 7},
+		{Path: "example.com/private", Version: "v1.0.0", Line: 8},
+	}
+
+	for _, tc := range []struct {
+		name  string
+		level outdated.Lag
+		want  []outdated.Upgrade
+	}{
+		{
+			name:  "patch",
+			level: outdated.LagPatch,
+			want: []outdated.Upgrade{
+				{Module: modules[0], NewPath: "github.com/spf13/cobra", NewVersion: "v1.7.1", Lag: outdated.LagPatch},
+				{Module: modules[2], NewPath: "github.com/docker/docker", NewVersion: "v24.0.7", Lag: outdated.LagPatch},
+			},
+		},
+		{
+			name:  "minor",
+			level: outdated.LagMinor,
+			want: []outdated.Upgrade{
+				{Module: modules[0], NewPath: "github.com/spf13/cobra", NewVersion: "v1.8.0", Lag: outdated.LagMinor},
+				{Module: modules[2], NewPath: "github.com/docker/docker", NewVersion: "v24.0.7", Lag: outdated.LagPatch},
+			},
+		},
+		{
+			name:  "major",
+			level: outdated.LagMajor,
+			want: []outdated.Upgrade{
+				{
+					Module:     modules[0],
+					NewPath:    "github.com/spf13/cobra/v2",
+					NewVersion: "v2.0.0",
+					Lag:        outdated.LagMajor,
+					Warning:    "module path changes from github.com/spf13/cobra to github.com/spf13/cobra/v2, import paths must be updated",
+				},
+				{
+					Module:     modules[1],
+					NewPath:    "gopkg.in/yaml.v3",
+					NewVersion: "v3.0.1",
+					Lag:        outdated.LagMajor,
+					Warning:    "module path changes from gopkg.in/yaml.v2 to gopkg.in/yaml.v3, import paths must be updated",
+				},
+				{
+					Module:     modules[2],
+					NewPath:    "github.com/docker/docker",
+					NewVersion: "v25.0.0+incompatible",
+					Lag:        outdated.LagMajor,
+					Warning:    "github.com/docker/docker is required without a go.mod file, v25.0.0 may require a module path change",
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := outdated.Upgrades(context.Background(), client, modules, &outdated.UpgradeOptions{Level: tc.level})
+			if err != nil {
+				t.Fatal(err)
+			}
+			testutil.AssertEqual(t, "", got, tc.want)
+		})
+	}
+}
+
+func TestGoGetCommands(t *testing.T) {
+	got := outdated.GoGetCommands([]outdated.Upgrade{
+		{NewPath: "github.com/spf13/cobra", NewVersion: "v1.8.0"},
+		{NewPath: "gopkg.in/yaml.v3", NewVersion: "v3.0.1"},
+	})
+
+	testutil.AssertEqual(t, "", got, []string{
+		"go get github.com/spf13/cobra@v1.8.0",
+		"go get gopkg.in/yaml.v3@v3.0.1",
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidEmailNotification is returned by ProjectOptions validation if the
+// email notification is not one of the available options.
+var ErrInvalidEmailNotification = errors.New("invalid email notification")
+
+// ValidationError holds all problems found when validating project options.
+// Every error wraps one of ErrInvalidEmailNotification, ErrInvalidRegexp,
+// ErrUnknownChannelID and ErrInvalidTag errors.
+type ValidationError struct {
+	Errors []error
+}
+
+func (e *ValidationError) Error() string {
+	s := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		s[i] = err.Error()
+	}
+	return strings.Join(s, "; ")
+}
+
+// Is reports whether any of the validation errors matches the target error.
+func (e *ValidationError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks project options before they are sent with the Add or update
+// methods. It checks that the email notification is one of the available
+// options, that all exclusion values are valid regular expressions and that
+// all referenced notification channels, webhooks and tags exist, by listing
+// them with the client services. Only services for which options have IDs are
+// called. All found problems are returned at once in a *ValidationError, while
+// errors from the API are returned as they are.
+func (o *ProjectOptions) Validate(ctx context.Context, client *Client) (err error) {
+	var errs []error
+
+	if o.EmailNotification != nil {
+		switch *o.EmailNotification {
+		case EmailNotificationNone, EmailNotificationInstant, EmailNotificationHourly,
+			EmailNotificationDaily, EmailNotificationWeekly, EmailNotificationDefault:
+		default:
+			errs = append(errs, fmt.Errorf("email_notification %q: %w", *o.EmailNotification, ErrInvalidEmailNotification))
+		}
+	}
+
+	for _, e := range o.Exclusions {
+		if _, err := regexp.Compile(e.Value); err != nil {
+			errs = append(errs, fmt.Errorf("exclude_version_regexp %q: %w: %v", e.Value, ErrInvalidRegexp, err))
+		}
+	}
+
+	for _, c := range []struct {
+		field string
+		ids   []string
+		list  func(ctx context.Context) (ids []string, err error)
+		err   error
+	}{
+		{
+			field: "slack_channels",
+			ids:   o.SlackIDs,
+			list: func(ctx context.Context) (ids []string, err error) {
+				channels, err := client.SlackChannels.List(ctx)
+				for _, c := range channels {
+					ids = append(ids, c.ID)
+				}
+				return ids, err
+			},
+			err: ErrUnknownChannelID,
+		},
+		{
+			field: "telegram_chats",
+			ids:   o.TelegramChatIDs,
+			list: func(ctx context.Context) (ids []string, err error) {
+				chats, err := client.TelegramChats.List(ctx)
+				for _, c := range chats {
+					ids = append(ids, c.ID)
+				}
+				return ids, err
+			},
+			err: ErrUnknownChannelID,
+		},
+		{
+			field: "discord_channels",
+			ids:   o.DiscordIDs,
+			list: func(ctx context.Context) (ids []string, err error) {
+				channels, err := client.DiscordChannels.List(ctx)
+				for _, c := range channels {
+					ids = append(ids, c.ID)
+				}
+				return ids, err
+			},
+			err: ErrUnknownChannelID,
+		},
+		{
+			field: "hangouts_chat_webhooks",
+			ids:   o.HangoutsChatWebhookIDs,
+			list:  webhookIDs(client.HangoutsChatWebhooks.List),
+			err:   ErrUnknownChannelID,
+		},
+		{
+			field: "microsoft_teams_webhooks",
+			ids:   o.MSTeamsWebhookIDs,
+			list:  webhookIDs(client.MicrosoftTeamsWebhooks.List),
+			err:   ErrUnknownChannelID,
+		},
+		{
+			field: "mattermost_webhooks",
+			ids:   o.MattermostWebhookIDs,
+			list:  webhookIDs(client.MattermostWebhooks.List),
+			err:   ErrUnknownChannelID,
+		},
+		{
+			field: "rocketchat_webhooks",
+			ids:   o.RocketchatWebhookIDs,
+			list:  webhookIDs(client.RocketchatWebhooks.List),
+			err:   ErrUnknownChannelID,
+		},
+		{
+			field: "matrix_rooms",
+			ids:   o.MatrixRoomIDs,
+			list: func(ctx context.Context) (ids []string, err error) {
+				rooms, err := client.MatrixRooms.List(ctx)
+				for _, r := range rooms {
+					ids = append(ids, r.ID)
+				}
+				return ids, err
+			},
+			err: ErrUnknownChannelID,
+		},
+		{
+			field: "webhooks",
+			ids:   o.WebhookIDs,
+			list:  webhookIDs(client.Webhooks.List),
+			err:   ErrUnknownChannelID,
+		},
+		{
+			field: "tags",
+			ids:   o.TagIDs,
+			list: func(ctx context.Context) (ids []string, err error) {
+				tags, err := client.Tags.List(ctx)
+				for _, t := range tags {
+					ids = append(ids, t.ID)
+				}
+				return ids, err
+			},
+			err: ErrInvalidTag,
+		},
+	} {
+		if len(c.ids) == 0 {
+			continue
+		}
+		existing, err := c.list(ctx)
+		if err != nil {
+			return fmt.Errorf("list %s: %w", c.field, err)
+		}
+		known := make(map[string]struct{}, len(existing))
+		for _, id := range existing {
+			known[id] = struct{}{}
+		}
+		for _, id := range c.ids {
+			if _, ok := known[id]; !ok {
+				errs = append(errs, fmt.Errorf("%s %q: %w", c.field, id, c.err))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func webhookIDs(list func(ctx context.Context) ([]Webhook, error)) func(ctx context.Context) (ids []string, err error) {
+	return func(ctx context.Context) (ids []string, err error) {
+		webhooks, err := list(ctx)
+		for _, w := range webhooks {
+			ids = append(ids, w.ID)
+		}
+		return ids, err
+	}
+}
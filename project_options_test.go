@@ -0,0 +1,99 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"newreleases.io/newreleases"
+)
+
+func TestProjectOptions_Validate(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	var calls []string
+	handle := func(path, body string) {
+		mux.HandleFunc(path, requireMethod("GET", func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, r.URL.Path)
+			newStaticHandler(body)(w, r)
+		}))
+	}
+	handle("/v1/slack-channels", `{"channels": [{"id": "slack1"}]}`)
+	handle("/v1/telegram-chats", `{"chats": [{"id": "telegram1"}]}`)
+	handle("/v1/webhooks", `{"webhooks": [{"id": "webhook1"}]}`)
+	handle("/v1/tags", `{"tags": [{"id": "tag1", "name": "Backend"}]}`)
+
+	email := newreleases.EmailNotification("monthly")
+	err := (&newreleases.ProjectOptions{
+		EmailNotification: &email,
+		SlackIDs:          []string{"slack1", "slack2"},
+		TelegramChatIDs:   []string{"telegram1"},
+		WebhookIDs:        []string{"webhook2"},
+		Exclusions: []newreleases.Exclusion{
+			{Value: `^v\d+\.\d+\.\d+$`},
+			{Value: `(beta`, Inverse: true},
+		},
+		TagIDs: []string{"tag1", "tag2"},
+	}).Validate(context.Background(), client)
+
+	var e *newreleases.ValidationError
+	if !errors.As(err, &e) {
+		t.Fatalf("got error %v, want validation error", err)
+	}
+	assertEqual(t, "number of errors", len(e.Errors), 5)
+	for i, want := range []error{
+		newreleases.ErrInvalidEmailNotification,
+		newreleases.ErrInvalidRegexp,
+		newreleases.ErrUnknownChannelID,
+		newreleases.ErrUnknownChannelID,
+		newreleases.ErrInvalidTag,
+	} {
+		if !errors.Is(e.Errors[i], want) {
+			t.Errorf("error %v: want %v", e.Errors[i], want)
+		}
+	}
+	assertEqual(t, "message", e.Errors[2].Error(), `slack_channels "slack2": unknown channel id`)
+	if !errors.Is(err, newreleases.ErrInvalidTag) {
+		t.Error("validation error is not invalid tag")
+	}
+	assertEqual(t, "calls", calls, []string{"/v1/slack-channels", "/v1/telegram-chats", "/v1/webhooks", "/v1/tags"})
+}
+
+func TestProjectOptions_Validate_valid(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	mux.HandleFunc("/v1/matrix-rooms", requireMethod("GET", newStaticHandler(`{"rooms": [{"id": "room1"}]}`)))
+
+	err := (&newreleases.ProjectOptions{
+		EmailNotification: &newreleases.EmailNotificationDaily,
+		MatrixRoomIDs:     []string{"room1"},
+		Exclusions:        []newreleases.Exclusion{{Value: `-rc\d*$`}},
+	}).Validate(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProjectOptions_Validate_apiError(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	mux.HandleFunc("/v1/discord-channels", requireMethod("GET", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+
+	err := (&newreleases.ProjectOptions{
+		DiscordIDs: []string{"discord1"},
+	}).Validate(context.Background(), client)
+	if !errors.Is(err, newreleases.ErrForbidden) {
+		t.Fatalf("got error %v, want %v", err, newreleases.ErrForbidden)
+	}
+}
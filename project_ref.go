@@ -0,0 +1,88 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ProjectRef references a project either by its ID or by its provider and
+// name. If the ID is set, provider and name are not used.
+type ProjectRef struct {
+	ID       string
+	Provider Provider
+	Name     string
+}
+
+// ProjectRefByID returns a reference to a project with the provided ID.
+func ProjectRefByID(id string) (r ProjectRef) {
+	return ProjectRef{ID: id}
+}
+
+// ProjectRefByName returns a reference to a project with the provided provider
+// and name.
+func ProjectRefByName(provider Provider, name string) (r ProjectRef) {
+	return ProjectRef{Provider: provider, Name: name}
+}
+
+// Ref returns a reference to the project by its ID, or by its provider and
+// name if the ID is not known.
+func (p *Project) Ref() (r ProjectRef) {
+	if p.ID != "" {
+		return ProjectRefByID(p.ID)
+	}
+	return ProjectRefByName(p.Provider, p.Name)
+}
+
+func (r ProjectRef) String() string {
+	if r.ID != "" {
+		return r.ID
+	}
+	return string(r.Provider) + "/" + r.Name
+}
+
+// path returns the escaped API path of the referenced project. Every segment
+// of the project name is escaped separately, so that names with a slash, like
+// GitHub repositories, keep their path structure, while characters like @, %,
+// ? and # can not change the endpoint.
+func (r ProjectRef) path() (path string, err error) {
+	if r.ID != "" {
+		if err := validateRefSegment(r.ID); err != nil {
+			return "", err
+		}
+		return "v1/projects/" + escapeRefSegment(r.ID), nil
+	}
+	if r.Provider == "" || r.Name == "" {
+		return "", errInvalidProjectRef
+	}
+	if err := validateRefSegment(string(r.Provider)); err != nil {
+		return "", err
+	}
+	segments := strings.Split(r.Name, "/")
+	for i, s := range segments {
+		if err := validateRefSegment(s); err != nil {
+			return "", err
+		}
+		segments[i] = escapeRefSegment(s)
+	}
+	return "v1/projects/" + escapeRefSegment(string(r.Provider)) + "/" + strings.Join(segments, "/"), nil
+}
+
+func validateRefSegment(s string) error {
+	switch s {
+	case "", ".", "..":
+		return errInvalidProjectRef
+	}
+	if strings.Contains(s, "/") {
+		return errInvalidProjectRef
+	}
+	return nil
+}
+
+func escapeRefSegment(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "@", "%40")
+}
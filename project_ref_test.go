@@ -0,0 +1,111 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"newreleases.io/newreleases"
+)
+
+func TestProjectRef_escaping(t *testing.T) {
+	for _, tc := range []struct {
+		ref  newreleases.ProjectRef
+		path string
+	}{
+		{
+			ref:  newreleases.ProjectRefByID("pf4w494lbjsd3ydp5hnf4gsptw"),
+			path: "/v1/projects/pf4w494lbjsd3ydp5hnf4gsptw",
+		},
+		{
+			ref:  newreleases.ProjectRefByName(newreleases.ProviderGitHub, "golang/go"),
+			path: "/v1/projects/github/golang/go",
+		},
+		{
+			ref:  newreleases.ProjectRefByName(newreleases.ProviderNPM, "@angular/core"),
+			path: "/v1/projects/npm/%40angular/core",
+		},
+		{
+			ref:  newreleases.ProjectRefByName(newreleases.ProviderMaven, "org.apache.commons:commons-lang3"),
+			path: "/v1/projects/maven/org.apache.commons:commons-lang3",
+		},
+		{
+			ref:  newreleases.ProjectRefByName(newreleases.ProviderPyPI, "odd%name?x#y"),
+			path: "/v1/projects/pypi/odd%25name%3Fx%23y",
+		},
+	} {
+		t.Run(tc.ref.String(), func(t *testing.T) {
+			client, mux, _, teardown := newClient(t, "")
+			defer teardown()
+
+			var got string
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				got = r.URL.EscapedPath()
+				newStaticHandler(projectsServiceGet)(w, r)
+			})
+
+			if _, err := client.Projects.Get(context.Background(), tc.ref); err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "project path", got, tc.path)
+
+			if _, _, err := client.Releases.List(context.Background(), tc.ref, 2); err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "releases path", got, tc.path+"/releases")
+
+			if _, err := client.Releases.GetNote(context.Background(), tc.ref, "v1.0.0"); err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "note path", got, tc.path+"/releases/v1.0.0/note")
+		})
+	}
+}
+
+func TestProjectRef_invalid(t *testing.T) {
+	client, _, _, teardown := newClient(t, "")
+	defer teardown()
+
+	for _, ref := range []newreleases.ProjectRef{
+		{},
+		newreleases.ProjectRefByID("a/b"),
+		newreleases.ProjectRefByName("", "golang/go"),
+		newreleases.ProjectRefByName(newreleases.ProviderGitHub, ""),
+		newreleases.ProjectRefByName(newreleases.ProviderGitHub, "../auth/keys"),
+		newreleases.ProjectRefByName(newreleases.ProviderGitHub, "golang//go"),
+	} {
+		t.Run(ref.String(), func(t *testing.T) {
+			if _, err := client.Projects.Get(context.Background(), ref); err == nil {
+				t.Fatal("expected error")
+			}
+			if err := client.Projects.Delete(context.Background(), ref); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestProject_Ref(t *testing.T) {
+	assertEqual(t, "id", (&newreleases.Project{ID: "pf4w494lbjsd3ydp5hnf4gsptw", Provider: "github", Name: "golang/go"}).Ref(), newreleases.ProjectRefByID("pf4w494lbjsd3ydp5hnf4gsptw"))
+	assertEqual(t, "name", (&newreleases.Project{Provider: "github", Name: "golang/go"}).Ref(), newreleases.ProjectRefByName(newreleases.ProviderGitHub, "golang/go"))
+}
+
+func TestProjectsService_Delete(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	var deleted bool
+	mux.HandleFunc("/v1/projects/npm/@angular/core", requireMethod("DELETE", func(w http.ResponseWriter, r *http.Request) {
+		deleted = true
+	}))
+
+	if err := client.Projects.Delete(context.Background(), newreleases.ProjectRefByName(newreleases.ProviderNPM, "@angular/core")); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "deleted", deleted, true)
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrProjectURLUnsupported is returned when a web URL does not belong to any of
+// the supported providers or a provider has no known web URL format.
+var ErrProjectURLUnsupported = errors.New("project url not supported")
+
+// projectURLFormats holds canonical web URL formats of projects for every
+// provider.
+var projectURLFormats = map[Provider]string{
+	ProviderBitbucket: "https://bitbucket.org/%s",
+	ProviderCargo:     "https://crates.io/crates/%s",
+	ProviderCocoaPods: "https://cocoapods.org/pods/%s",
+	ProviderCodeberg:  "https://codeberg.org/%s/releases",
+	ProviderDockerHub: "https://hub.docker.com/r/%s",
+	ProviderGems:      "https://rubygems.org/gems/%s",
+	ProviderGHCR:      "https://ghcr.io/%s",
+	ProviderGitHub:    "https://github.com/%s/releases",
+	ProviderGitLab:    "https://gitlab.com/%s/-/tags",
+	ProviderGo:        "https://pkg.go.dev/%s",
+	ProviderHex:       "https://hex.pm/packages/%s",
+	ProviderMaven:     "https://search.maven.org/artifact/%s",
+	ProviderNPM:       "https://www.npmjs.com/package/%s",
+	ProviderNuGet:     "https://www.nuget.org/packages/%s",
+	ProviderPackagist: "https://packagist.org/packages/%s",
+	ProviderPub:       "https://pub.dev/packages/%s",
+	ProviderPyPI:      "https://pypi.org/project/%s/",
+	ProviderQuay:      "https://quay.io/repository/%s",
+	ProviderYarn:      "https://yarnpkg.com/package/%s",
+}
+
+// ProjectURL returns the canonical web URL of a project referenced by its
+// provider and name, in the same form as the Project URL field.
+func ProjectURL(provider Provider, name string) (u string, err error) {
+	format, ok := projectURLFormats[provider]
+	if !ok {
+		return "", fmt.Errorf("provider %s: %w", provider, ErrProjectURLUnsupported)
+	}
+	if name == "" {
+		return "", errors.New("empty project name")
+	}
+	if provider == ProviderMaven {
+		i := strings.LastIndex(name, ":")
+		if i < 0 {
+			return "", fmt.Errorf("invalid maven project name %q", name)
+		}
+		name = name[:i] + "/" + name[i+1:]
+	}
+	return fmt.Sprintf(format, name), nil
+}
+
+// ParseProjectURL returns the provider and the project name from a web URL of
+// a project page, such as https://github.com/foo/bar,
+// https://www.npmjs.com/package/@a/b or https://hub.docker.com/r/library/nginx.
+// URLs of pages within the project, like releases, tags or specific versions,
+// are also recognized. The URL scheme is optional.
+func ParseProjectURL(rawURL string) (provider Provider, name string, err error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", err
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	var segments []string
+	for _, s := range strings.Split(u.Path, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+
+	p, name := parseProjectURLPath(host, segments, u.Query())
+	if p == "" || name == "" {
+		return "", "", fmt.Errorf("%s: %w", rawURL, ErrProjectURLUnsupported)
+	}
+	return p, name, nil
+}
+
+func parseProjectURLPath(host string, s []string, q url.Values) (provider Provider, name string) {
+	switch host {
+	case "github.com":
+		// GitHub Container Registry package pages.
+		if len(s) >= 6 && (s[0] == "orgs" || s[0] == "users") && s[2] == "packages" && s[3] == "container" && s[4] == "package" {
+			return ProviderGHCR, strings.ToLower(s[1] + "/" + s[5])
+		}
+		if len(s) >= 5 && s[2] == "pkgs" && s[3] == "container" {
+			return ProviderGHCR, strings.ToLower(s[0] + "/" + s[4])
+		}
+		return ProviderGitHub, urlPathPrefix(s, 2, ".git")
+	case "gitlab.com":
+		for i, v := range s {
+			if v == "-" {
+				s = s[:i]
+				break
+			}
+		}
+		if len(s) < 2 {
+			return "", ""
+		}
+		return ProviderGitLab, strings.TrimSuffix(strings.Join(s, "/"), ".git")
+	case "bitbucket.org":
+		return ProviderBitbucket, urlPathPrefix(s, 2, ".git")
+	case "codeberg.org":
+		return ProviderCodeberg, urlPathPrefix(s, 2, ".git")
+	case "npmjs.com":
+		return ProviderNPM, scopedPackageName(s, "package")
+	case "yarnpkg.com", "classic.yarnpkg.com":
+		if len(s) > 0 && s[0] == "en" {
+			s = s[1:]
+		}
+		if len(s) == 1 && s[0] == "package" {
+			return ProviderYarn, q.Get("name")
+		}
+		return ProviderYarn, scopedPackageName(s, "package")
+	case "pypi.org":
+		if len(s) >= 2 && s[0] == "project" {
+			return ProviderPyPI, s[1]
+		}
+	case "search.maven.org", "central.sonatype.com", "mvnrepository.com":
+		if len(s) >= 3 && s[0] == "artifact" {
+			return ProviderMaven, s[1] + ":" + s[2]
+		}
+	case "nuget.org":
+		if len(s) >= 2 && s[0] == "packages" {
+			return ProviderNuGet, s[1]
+		}
+	case "packagist.org":
+		if len(s) >= 3 && s[0] == "packages" {
+			return ProviderPackagist, strings.ToLower(s[1] + "/" + s[2])
+		}
+	case "crates.io":
+		if len(s) >= 2 && s[0] == "crates" {
+			return ProviderCargo, s[1]
+		}
+	case "rubygems.org":
+		if len(s) >= 2 && s[0] == "gems" {
+			return ProviderGems, s[1]
+		}
+	case "hex.pm":
+		if len(s) >= 2 && s[0] == "packages" {
+			return ProviderHex, s[1]
+		}
+	case "pub.dev":
+		if len(s) >= 2 && s[0] == "packages" {
+			return ProviderPub, s[1]
+		}
+	case "cocoapods.org":
+		if len(s) >= 2 && s[0] == "pods" {
+			return ProviderCocoaPods, s[1]
+		}
+	case "pkg.go.dev":
+		if len(s) > 0 {
+			name = strings.Join(s, "/")
+			if i := strings.Index(name, "@"); i >= 0 {
+				name = name[:i]
+			}
+			return ProviderGo, name
+		}
+	case "hub.docker.com":
+		switch {
+		case len(s) >= 2 && s[0] == "_":
+			return ProviderDockerHub, "library/" + s[1]
+		case len(s) >= 3 && (s[0] == "r" || s[0] == "repository"):
+			if s[0] == "repository" && len(s) >= 4 && s[1] == "docker" {
+				return ProviderDockerHub, s[2] + "/" + s[3]
+			}
+			return ProviderDockerHub, s[1] + "/" + s[2]
+		}
+	case "quay.io":
+		if len(s) >= 3 && s[0] == "repository" {
+			return ProviderQuay, s[1] + "/" + s[2]
+		}
+	case "ghcr.io":
+		if len(s) >= 2 {
+			return ProviderGHCR, strings.ToLower(strings.Join(s, "/"))
+		}
+	}
+	return "", ""
+}
+
+// urlPathPrefix returns the first n path segments joined with a slash, without the
+// provided suffix, or an empty string if there are less than n segments.
+func urlPathPrefix(s []string, n int, suffix string) string {
+	if len(s) < n {
+		return ""
+	}
+	return strings.TrimSuffix(strings.Join(s[:n], "/"), suffix)
+}
+
+// scopedPackageName returns a package name, that may be scoped, from path segments
+// that follow the provided first segment.
+func scopedPackageName(s []string, first string) string {
+	if len(s) < 2 || s[0] != first {
+		return ""
+	}
+	if strings.HasPrefix(s[1], "@") {
+		if len(s) < 3 {
+			return ""
+		}
+		return s[1] + "/" + s[2]
+	}
+	return s[1]
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases_test
+
+import (
+	"errors"
+	"testing"
+
+	"newreleases.io/newreleases"
+)
+
+func TestParseProjectURL(t *testing.T) {
+	for _, tc := range []struct {
+		url      string
+		provider newreleases.Provider
+		name     string
+	}{
+		{url: "https://github.com/foo/bar", provider: "github", name: "foo/bar"},
+		{url: "https://github.com/foo/bar/", provider: "github", name: "foo/bar"},
+		{url: "http://www.github.com/foo/bar.git", provider: "github", name: "foo/bar"},
+		{url: "github.com/foo/bar", provider: "github", name: "foo/bar"},
+		{url: "https://github.com/golang/go/releases", provider: "github", name: "golang/go"},
+		{url: "https://github.com/golang/go/releases/tag/go1.21.0", provider: "github", name: "golang/go"},
+		{url: "https://github.com/golang/go/tree/master/src?tab=readme#top", provider: "github", name: "golang/go"},
+		{url: "https://github.com/orgs/Owner/packages/container/package/Image", provider: "ghcr", name: "owner/image"},
+		{url: "https://github.com/users/owner/packages/container/package/image", provider: "ghcr", name: "owner/image"},
+		{url: "https://github.com/owner/repo/pkgs/container/image", provider: "ghcr", name: "owner/image"},
+		{url: "https://ghcr.io/owner/image", provider: "ghcr", name: "owner/image"},
+		{url: "https://gitlab.com/gitlab-org/gitlab-runner", provider: "gitlab", name: "gitlab-org/gitlab-runner"},
+		{url: "https://gitlab.com/group/subgroup/project/-/tags", provider: "gitlab", name: "group/subgroup/project"},
+		{url: "https://bitbucket.org/atlassian/python-bitbucket/src/master/", provider: "bitbucket", name: "atlassian/python-bitbucket"},
+		{url: "https://codeberg.org/forgejo/forgejo/releases", provider: "codeberg", name: "forgejo/forgejo"},
+		{url: "https://www.npmjs.com/package/react", provider: "npm", name: "react"},
+		{url: "https://www.npmjs.com/package/@a/b", provider: "npm", name: "@a/b"},
+		{url: "https://www.npmjs.com/package/%40a%2Fb", provider: "npm", name: "@a/b"},
+		{url: "https://npmjs.com/package/@angular/core/v/16.2.0", provider: "npm", name: "@angular/core"},
+		{url: "https://yarnpkg.com/package/@babel/core", provider: "yarn", name: "@babel/core"},
+		{url: "https://yarnpkg.com/package?name=lodash", provider: "yarn", name: "lodash"},
+		{url: "https://classic.yarnpkg.com/en/package/lodash", provider: "yarn", name: "lodash"},
+		{url: "https://pypi.org/project/Django/", provider: "pypi", name: "Django"},
+		{url: "https://pypi.org/project/requests/2.31.0/", provider: "pypi", name: "requests"},
+		{url: "https://search.maven.org/artifact/org.apache.commons/commons-lang3", provider: "maven", name: "org.apache.commons:commons-lang3"},
+		{url: "https://central.sonatype.com/artifact/org.apache.commons/commons-lang3/3.13.0", provider: "maven", name: "org.apache.commons:commons-lang3"},
+		{url: "https://mvnrepository.com/artifact/junit/junit", provider: "maven", name: "junit:junit"},
+		{url: "https://www.nuget.org/packages/Newtonsoft.Json/13.0.3", provider: "nuget", name: "Newtonsoft.Json"},
+		{url: "https://packagist.org/packages/Symfony/Console", provider: "packagist", name: "symfony/console"},
+		{url: "https://crates.io/crates/serde", provider: "cargo", name: "serde"},
+		{url: "https://crates.io/crates/serde/1.0.188", provider: "cargo", name: "serde"},
+		{url: "https://rubygems.org/gems/rails/versions/7.0.8", provider: "gems", name: "rails"},
+		{url: "https://hex.pm/packages/phoenix", provider: "hex", name: "phoenix"},
+		{url: "https://pub.dev/packages/http/versions", provider: "pub", name: "http"},
+		{url: "https://cocoapods.org/pods/AFNetworking", provider: "cocoapods", name: "AFNetworking"},
+		{url: "https://pkg.go.dev/github.com/gorilla/mux", provider: "go", name: "github.com/gorilla/mux"},
+		{url: "https://pkg.go.dev/golang.org/x/net@v0.15.0", provider: "go", name: "golang.org/x/net"},
+		{url: "https://hub.docker.com/r/library/nginx", provider: "dockerhub", name: "library/nginx"},
+		{url: "https://hub.docker.com/_/nginx", provider: "dockerhub", name: "library/nginx"},
+		{url: "https://hub.docker.com/r/grafana/grafana/tags", provider: "dockerhub", name: "grafana/grafana"},
+		{url: "https://hub.docker.com/repository/docker/owner/image/general", provider: "dockerhub", name: "owner/image"},
+		{url: "https://quay.io/repository/prometheus/prometheus?tab=tags", provider: "quay", name: "prometheus/prometheus"},
+	} {
+		t.Run(tc.url, func(t *testing.T) {
+			provider, name, err := newreleases.ParseProjectURL(tc.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "provider", provider, tc.provider)
+			assertEqual(t, "name", name, tc.name)
+		})
+	}
+}
+
+func TestParseProjectURL_unsupported(t *testing.T) {
+	for _, u := range []string{
+		"https://example.com/foo/bar",
+		"https://github.com/foo",
+		"https://www.npmjs.com/package/@scope",
+		"https://pypi.org/search/?q=django",
+		"https://hub.docker.com/search",
+	} {
+		t.Run(u, func(t *testing.T) {
+			_, _, err := newreleases.ParseProjectURL(u)
+			if !errors.Is(err, newreleases.ErrProjectURLUnsupported) {
+				t.Fatalf("got error %v, want %v", err, newreleases.ErrProjectURLUnsupported)
+			}
+		})
+	}
+}
+
+func TestProjectURL(t *testing.T) {
+	for _, tc := range []struct {
+		provider newreleases.Provider
+		name     string
+		url      string
+	}{
+		{provider: "github", name: "golang/go", url: "https://github.com/golang/go/releases"},
+		{provider: "gitlab", name: "group/subgroup/project", url: "https://gitlab.com/group/subgroup/project/-/tags"},
+		{provider: "npm", name: "@angular/core", url: "https://www.npmjs.com/package/@angular/core"},
+		{provider: "pypi", name: "django", url: "https://pypi.org/project/django/"},
+		{provider: "maven", name: "org.apache.commons:commons-lang3", url: "https://search.maven.org/artifact/org.apache.commons/commons-lang3"},
+		{provider: "dockerhub", name: "library/nginx", url: "https://hub.docker.com/r/library/nginx"},
+		{provider: "go", name: "golang.org/x/net", url: "https://pkg.go.dev/golang.org/x/net"},
+		{provider: "cargo", name: "serde", url: "https://crates.io/crates/serde"},
+	} {
+		t.Run(string(tc.provider), func(t *testing.T) {
+			got, err := newreleases.ProjectURL(tc.provider, tc.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "url", got, tc.url)
+
+			provider, name, err := newreleases.ParseProjectURL(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "provider", provider, tc.provider)
+			assertEqual(t, "name", name, tc.name)
+		})
+	}
+
+	if _, err := newreleases.ProjectURL("unknown", "name"); !errors.Is(err, newreleases.ErrProjectURLUnsupported) {
+		t.Fatalf("got error %v, want %v", err, newreleases.ErrProjectURLUnsupported)
+	}
+}
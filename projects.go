@@ -7,6 +7,7 @@ package newreleases
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -20,7 +21,7 @@ type ProjectsService service
 type Project struct {
 	ID                     string            `json:"id"`
 	Name                   string            `json:"name"`
-	Provider               string            `json:"provider"`
+	Provider               Provider          `json:"provider"`
 	URL                    string            `json:"url"`
 	EmailNotification      EmailNotification `json:"email_notification,omitempty"`
 	SlackIDs               []string          `json:"slack_channels,omitempty"`
@@ -69,7 +70,7 @@ type ProjectListOptions struct {
 	Page     int
 	Order    ProjectListOrder
 	Reverse  bool
-	Provider string
+	Provider Provider
 	TagID    string
 }
 
@@ -88,7 +89,7 @@ var (
 func (s *ProjectsService) List(ctx context.Context, o ProjectListOptions) (projects []Project, lastPage int, err error) {
 	path := "v1/projects"
 	if o.Provider != "" {
-		path += "/" + o.Provider
+		path += "/" + string(o.Provider)
 	}
 	q := make(url.Values)
 	if o.Page < 1 {
@@ -116,14 +117,48 @@ func (s *ProjectsService) List(ctx context.Context, o ProjectListOptions) (proje
 	return r.Projects, r.TotalPages, err
 }
 
+// ListAll returns tracked projects from all pages. The Page field of the
+// options is ignored.
+func (s *ProjectsService) ListAll(ctx context.Context, o ProjectListOptions) (projects []Project, err error) {
+	err = s.Walk(ctx, o, func(p Project) (stop bool, err error) {
+		projects = append(projects, p)
+		return false, nil
+	})
+	return projects, err
+}
+
+// Walk calls the function for every tracked project, page by page, until the
+// last page is listed, or the function returns true or an error. The Page
+// field of the options is ignored.
+func (s *ProjectsService) Walk(ctx context.Context, o ProjectListOptions, fn func(p Project) (stop bool, err error)) (err error) {
+	for o.Page = 1; ; o.Page++ {
+		projects, lastPage, err := s.List(ctx, o)
+		if err != nil {
+			return err
+		}
+		for _, p := range projects {
+			stop, err := fn(p)
+			if err != nil {
+				return err
+			}
+			if stop {
+				return nil
+			}
+		}
+		if o.Page >= lastPage || len(projects) == 0 {
+			return nil
+		}
+	}
+}
+
 // Search performs a search with provided query on names of all tracked
 // projects. Provider argument is optional and all projects are searched if it
 // is a blank string.
-func (s *ProjectsService) Search(ctx context.Context, query, provider string) (projects []Project, err error) {
+func (s *ProjectsService) Search(ctx context.Context, query string, provider Provider) (projects []Project, err error) {
 	q := make(url.Values)
 	q.Set("q", query)
 	if provider != "" {
-		q.Set("provider", provider)
+		q.Set("provider", string(provider))
 	}
 
 	type projectsSearchResponse struct {
@@ -135,19 +170,25 @@ func (s *ProjectsService) Search(ctx context.Context, query, provider string) (p
 	return r.Projects, err
 }
 
+// Get returns a specific project referenced by its ID or by its provider and
+// name.
+func (s *ProjectsService) Get(ctx context.Context, ref ProjectRef) (project *Project, err error) {
+	path, err := ref.path()
+	if err != nil {
+		return nil, err
+	}
+	err = s.client.request(ctx, http.MethodGet, path, nil, &project)
+	return project, err
+}
+
 // GetByID returns a specific project referenced by its ID.
 func (s *ProjectsService) GetByID(ctx context.Context, id string) (project *Project, err error) {
-	return s.get(ctx, id)
+	return s.Get(ctx, ProjectRefByID(id))
 }
 
 // GetByName returns a specific project referenced by its provider and name.
-func (s *ProjectsService) GetByName(ctx context.Context, provider, name string) (project *Project, err error) {
-	return s.get(ctx, provider+"/"+name)
-}
-
-func (s *ProjectsService) get(ctx context.Context, projectRef string) (project *Project, err error) {
-	err = s.client.request(ctx, http.MethodGet, "v1/projects/"+projectRef, nil, &project)
-	return project, err
+func (s *ProjectsService) GetByName(ctx context.Context, provider Provider, name string) (project *Project, err error) {
+	return s.Get(ctx, ProjectRefByName(provider, name))
 }
 
 // ProjectOptions holds information for setting options for a specific project.
@@ -175,11 +216,11 @@ type ProjectOptions struct {
 }
 
 // Add adds a new project to be tracked.
-func (s *ProjectsService) Add(ctx context.Context, provider, name string, o *ProjectOptions) (project *Project, err error) {
+func (s *ProjectsService) Add(ctx context.Context, provider Provider, name string, o *ProjectOptions) (project *Project, err error) {
 
 	type projectAddRequest struct {
-		Provider string `json:"provider"`
-		Name     string `json:"name"`
+		Provider Provider `json:"provider"`
+		Name     string   `json:"name"`
 		*ProjectOptions
 	}
 
@@ -191,31 +232,67 @@ func (s *ProjectsService) Add(ctx context.Context, provider, name string, o *Pro
 	return project, err
 }
 
+// AddOrUpdate adds a new project to be tracked if it is not already tracked,
+// or changes options of the tracked project otherwise. The added return value
+// reports whether the project has been added. If the project is already
+// tracked and options are nil, the project is returned without changes. It is
+// safe to call this method repeatedly with the same arguments.
+func (s *ProjectsService) AddOrUpdate(ctx context.Context, provider Provider, name string, o *ProjectOptions) (project *Project, added bool, err error) {
+	project, err = s.GetByName(ctx, provider, name)
+	if errors.Is(err, ErrNotFound) {
+		project, err = s.Add(ctx, provider, name, o)
+		if !errors.Is(err, ErrProjectAlreadyExists) {
+			return project, err == nil, err
+		}
+		// The project was added concurrently after it was checked.
+		project, err = s.GetByName(ctx, provider, name)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if o == nil {
+		return project, false, nil
+	}
+	project, err = s.Update(ctx, project.Ref(), o)
+	return project, false, err
+}
+
+// Update changes options of a project referenced by its ID or by its provider
+// and name.
+func (s *ProjectsService) Update(ctx context.Context, ref ProjectRef, o *ProjectOptions) (project *Project, err error) {
+	path, err := ref.path()
+	if err != nil {
+		return nil, err
+	}
+	err = s.client.request(ctx, http.MethodPost, path, o, &project)
+	return project, err
+}
+
 // UpdateByID changes project options referenced by its ID.
 func (s *ProjectsService) UpdateByID(ctx context.Context, id string, o *ProjectOptions) (project *Project, err error) {
-	return s.update(ctx, id, o)
+	return s.Update(ctx, ProjectRefByID(id), o)
 }
 
 // UpdateByName changes project options referenced by its provider and name.
-func (s *ProjectsService) UpdateByName(ctx context.Context, provider, name string, o *ProjectOptions) (project *Project, err error) {
-	return s.update(ctx, provider+"/"+name, o)
+func (s *ProjectsService) UpdateByName(ctx context.Context, provider Provider, name string, o *ProjectOptions) (project *Project, err error) {
+	return s.Update(ctx, ProjectRefByName(provider, name), o)
 }
 
-func (s *ProjectsService) update(ctx context.Context, projectRef string, o *ProjectOptions) (project *Project, err error) {
-	err = s.client.request(ctx, http.MethodPost, "v1/projects/"+projectRef, o, &project)
-	return project, err
+// Delete removes a project referenced by its ID or by its provider and name.
+func (s *ProjectsService) Delete(ctx context.Context, ref ProjectRef) (err error) {
+	path, err := ref.path()
+	if err != nil {
+		return err
+	}
+	return s.client.request(ctx, http.MethodDelete, path, nil, nil)
 }
 
 // DeleteByID removes a project referenced by its ID.
 func (s *ProjectsService) DeleteByID(ctx context.Context, id string) (err error) {
-	return s.delete(ctx, id)
+	return s.Delete(ctx, ProjectRefByID(id))
 }
 
 // DeleteByName removes a project referenced by its provider and name.
-func (s *ProjectsService) DeleteByName(ctx context.Context, provider, name string) (err error) {
-	return s.delete(ctx, provider+"/"+name)
-}
-
-func (s *ProjectsService) delete(ctx context.Context, projectRref string) (err error) {
-	return s.client.request(ctx, http.MethodDelete, "v1/projects/"+projectRref, nil, nil)
+func (s *ProjectsService) DeleteByName(ctx context.Context, provider Provider, name string) (err error) {
+	return s.Delete(ctx, ProjectRefByName(provider, name))
 }
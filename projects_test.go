@@ -180,6 +180,44 @@ func TestProjectsService_List_providerReverse(t *testing.T) {
 	assertEqual(t, "", got, []newreleases.Project(nil))
 }
 
+func TestProjectsService_ListAll(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	mux.HandleFunc("/v1/projects/github", requireMethod("GET", newPagedStaticHandler(projectsServiceList...)))
+
+	got, err := client.Projects.ListAll(context.Background(), newreleases.ProjectListOptions{
+		Page:     5,
+		Provider: "github",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "", got, append(append([]newreleases.Project(nil), projectsServiceListWant[0]...), projectsServiceListWant[1]...))
+}
+
+func TestProjectsService_Walk_stop(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	var pages int
+	mux.HandleFunc("/v1/projects", requireMethod("GET", func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		newPagedStaticHandler(projectsServiceList...)(w, r)
+	}))
+
+	var got []string
+	err := client.Projects.Walk(context.Background(), newreleases.ProjectListOptions{}, func(p newreleases.Project) (stop bool, err error) {
+		got = append(got, p.Name)
+		return true, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "projects", got, []string{"golang/go"})
+	assertEqual(t, "pages", pages, 1)
+}
+
 var (
 	projectsServiceList = []string{`
 	{
@@ -482,3 +520,103 @@ func TestProjectsService_DeleteByName(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestProjectsService_AddOrUpdate(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		tracked   bool
+		options   *newreleases.ProjectOptions
+		wantAdded bool
+		wantCalls []string
+	}{
+		{
+			name:      "add",
+			options:   projectOptions,
+			wantAdded: true,
+			wantCalls: []string{"GET /v1/projects/github/golang/go", "POST /v1/projects"},
+		},
+		{
+			name:      "update",
+			tracked:   true,
+			options:   projectOptions,
+			wantCalls: []string{"GET /v1/projects/github/golang/go", "POST /v1/projects/pf4w494lbjsd3ydp5hnf4gsptw"},
+		},
+		{
+			name:      "unchanged",
+			tracked:   true,
+			wantCalls: []string{"GET /v1/projects/github/golang/go"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client, mux, _, teardown := newClient(t, "")
+			defer teardown()
+
+			var calls []string
+			mux.HandleFunc("/v1/projects/", func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.Path)
+				if r.Method == http.MethodGet && !tc.tracked {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				newStaticHandler(projectsServiceGet)(w, r)
+			})
+			mux.HandleFunc("/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.Path)
+				newStaticHandler(projectsServiceGet)(w, r)
+			})
+
+			got, added, err := client.Projects.AddOrUpdate(context.Background(), "github", "golang/go", tc.options)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assertEqual(t, "project", got, projectsServiceGetWant)
+			assertEqual(t, "added", added, tc.wantAdded)
+			assertEqual(t, "calls", calls, tc.wantCalls)
+		})
+	}
+}
+
+func TestProjectsService_AddOrUpdate_error(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	mux.HandleFunc("/v1/projects/github/golang/go", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, added, err := client.Projects.AddOrUpdate(context.Background(), "github", "golang/go", projectOptions)
+	if err != newreleases.ErrForbidden {
+		t.Fatalf("got error %v, want %v", err, newreleases.ErrForbidden)
+	}
+	assertEqual(t, "added", added, false)
+}
+
+func TestProjectsService_AddOrUpdate_concurrentlyAdded(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	var gets int
+	mux.HandleFunc("/v1/projects/github/golang/go", requireMethod("GET", func(w http.ResponseWriter, r *http.Request) {
+		gets++
+		if gets == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		newStaticHandler(projectsServiceGet)(w, r)
+	}))
+	mux.HandleFunc("/v1/projects", requireMethod("POST", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", jsonContentType)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, `{"errors": ["Project already exists."]}`)
+	}))
+	mux.HandleFunc("/v1/projects/pf4w494lbjsd3ydp5hnf4gsptw", requireMethod("POST", newStaticHandler(projectsServiceGet)))
+
+	got, added, err := client.Projects.AddOrUpdate(context.Background(), "github", "golang/go", projectOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertEqual(t, "project", got, projectsServiceGetWant)
+	assertEqual(t, "added", added, false)
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Provider is the name of a service that hosts projects, such as github or npm,
+// as returned by ProvidersService.List.
+type Provider string
+
+// Supported project providers.
+const (
+	ProviderBitbucket Provider = "bitbucket"
+	ProviderCargo     Provider = "cargo"
+	ProviderCocoaPods Provider = "cocoapods"
+	ProviderCodeberg  Provider = "codeberg"
+	ProviderDockerHub Provider = "dockerhub"
+	ProviderGems      Provider = "gems"
+	ProviderGHCR      Provider = "ghcr"
+	ProviderGitHub    Provider = "github"
+	ProviderGitLab    Provider = "gitlab"
+	ProviderGo        Provider = "go"
+	ProviderHex       Provider = "hex"
+	ProviderMaven     Provider = "maven"
+	ProviderNPM       Provider = "npm"
+	ProviderNuGet     Provider = "nuget"
+	ProviderPackagist Provider = "packagist"
+	ProviderPub       Provider = "pub"
+	ProviderPyPI      Provider = "pypi"
+	ProviderQuay      Provider = "quay"
+	ProviderYarn      Provider = "yarn"
+)
+
+// Errors that are returned when validating providers and project names.
+var (
+	ErrUnknownProvider    = errors.New("unknown provider")
+	ErrInvalidProjectName = errors.New("invalid project name")
+)
+
+// ProviderInfo holds descriptive information about a provider and the format of
+// its project names.
+type ProviderInfo struct {
+	Provider    Provider
+	DisplayName string
+	// NameFormat describes the format of project names, for example
+	// owner/repository.
+	NameFormat string
+	// CaseSensitive is false if project names that differ only in letter case
+	// reference the same project.
+	CaseSensitive bool
+
+	pattern   *regexp.Regexp
+	normalize func(name string) string
+}
+
+var (
+	nameOwnerRepository = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*/[A-Za-z0-9._-]+$`)
+	nameNPM             = regexp.MustCompile(`^(@[a-z0-9-~][a-z0-9-._~]*/)?[A-Za-z0-9-~][A-Za-z0-9-._~]*$`)
+	nameLowercase       = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	nameImage           = regexp.MustCompile(`^[a-z0-9]+([._-]+[a-z0-9]+)*(/[a-z0-9]+([._-]+[a-z0-9]+)*)+$`)
+)
+
+var providers = map[Provider]ProviderInfo{
+	ProviderBitbucket: {
+		DisplayName: "Bitbucket",
+		NameFormat:  "owner/repository",
+		pattern:     nameOwnerRepository,
+		normalize:   strings.ToLower,
+	},
+	ProviderCargo: {
+		DisplayName: "Cargo",
+		NameFormat:  "crate",
+		pattern:     regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`),
+		normalize:   strings.ToLower,
+	},
+	ProviderCocoaPods: {
+		DisplayName:   "CocoaPods",
+		NameFormat:    "pod",
+		CaseSensitive: true,
+		pattern:       regexp.MustCompile(`^[A-Za-z0-9_+.-]+$`),
+	},
+	ProviderCodeberg: {
+		DisplayName: "Codeberg",
+		NameFormat:  "owner/repository",
+		pattern:     nameOwnerRepository,
+		normalize:   strings.ToLower,
+	},
+	ProviderDockerHub: {
+		DisplayName: "Docker Hub",
+		NameFormat:  "namespace/repository",
+		pattern:     nameImage,
+		normalize: func(name string) string {
+			name = strings.ToLower(name)
+			if !strings.Contains(name, "/") {
+				name = "library/" + name
+			}
+			return name
+		},
+	},
+	ProviderGems: {
+		DisplayName:   "RubyGems",
+		NameFormat:    "gem",
+		CaseSensitive: true,
+		pattern:       regexp.MustCompile(`^[A-Za-z0-9._-]+$`),
+	},
+	ProviderGHCR: {
+		DisplayName: "GitHub Container Registry",
+		NameFormat:  "owner/image",
+		pattern:     nameImage,
+		normalize:   strings.ToLower,
+	},
+	ProviderGitHub: {
+		DisplayName: "GitHub",
+		NameFormat:  "owner/repository",
+		pattern:     nameOwnerRepository,
+		normalize:   strings.ToLower,
+	},
+	ProviderGitLab: {
+		DisplayName: "GitLab",
+		NameFormat:  "group/[subgroup/]project",
+		pattern:     regexp.MustCompile(`^[A-Za-z0-9_.-]+(/[A-Za-z0-9_.-]+)+$`),
+		normalize:   strings.ToLower,
+	},
+	ProviderGo: {
+		DisplayName:   "Go",
+		NameFormat:    "module path",
+		CaseSensitive: true,
+		pattern:       regexp.MustCompile(`^[a-z0-9-]+(\.[a-z0-9-]+)+(/[A-Za-z0-9._~+-]+)*$`),
+	},
+	ProviderHex: {
+		DisplayName: "Hex",
+		NameFormat:  "package",
+		pattern:     nameLowercase,
+		normalize:   strings.ToLower,
+	},
+	ProviderMaven: {
+		DisplayName:   "Maven",
+		NameFormat:    "groupId:artifactId",
+		CaseSensitive: true,
+		pattern:       regexp.MustCompile(`^[A-Za-z0-9_.-]+:[A-Za-z0-9_.-]+$`),
+		normalize: func(name string) string {
+			if !strings.Contains(name, ":") {
+				if i := strings.LastIndex(name, "/"); i >= 0 {
+					name = name[:i] + ":" + name[i+1:]
+				}
+			}
+			return name
+		},
+	},
+	ProviderNPM: {
+		DisplayName:   "npm",
+		NameFormat:    "[@scope/]package",
+		CaseSensitive: true,
+		pattern:       nameNPM,
+	},
+	ProviderNuGet: {
+		DisplayName: "NuGet",
+		NameFormat:  "package",
+		pattern:     regexp.MustCompile(`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`),
+		normalize:   strings.ToLower,
+	},
+	ProviderPackagist: {
+		DisplayName: "Packagist",
+		NameFormat:  "vendor/package",
+		pattern:     regexp.MustCompile(`^[a-z0-9]([_.-]?[a-z0-9]+)*/[a-z0-9](([_.]|-{1,2})?[a-z0-9]+)*$`),
+		normalize:   strings.ToLower,
+	},
+	ProviderPub: {
+		DisplayName: "Pub",
+		NameFormat:  "package",
+		pattern:     nameLowercase,
+		normalize:   strings.ToLower,
+	},
+	ProviderPyPI: {
+		DisplayName: "PyPI",
+		NameFormat:  "package",
+		pattern:     regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?$`),
+		normalize: func(name string) string {
+			// Normalization from PEP 503.
+			return pypiSeparators.ReplaceAllString(strings.ToLower(name), "-")
+		},
+	},
+	ProviderQuay: {
+		DisplayName: "Quay",
+		NameFormat:  "namespace/repository",
+		pattern:     nameImage,
+		normalize:   strings.ToLower,
+	},
+	ProviderYarn: {
+		DisplayName:   "Yarn",
+		NameFormat:    "[@scope/]package",
+		CaseSensitive: true,
+		pattern:       nameNPM,
+	},
+}
+
+var pypiSeparators = regexp.MustCompile(`[-_.]+`)
+
+// Providers returns information about all supported providers sorted by
+// their names.
+func Providers() (infos []ProviderInfo) {
+	infos = make([]ProviderInfo, 0, len(providers))
+	for p := range providers {
+		info, _ := p.Info()
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Provider < infos[j].Provider
+	})
+	return infos
+}
+
+// Info returns information about the provider and false if the provider is not
+// known.
+func (p Provider) Info() (info ProviderInfo, ok bool) {
+	info, ok = providers[p]
+	info.Provider = p
+	return info, ok
+}
+
+func (p Provider) String() string {
+	return string(p)
+}
+
+// Validate returns an error wrapping ErrUnknownProvider if the provider is not
+// known or ErrInvalidProjectName if the project name does not have the format
+// that is expected by the provider. Names are validated as they are, so it may
+// be required to call Normalize first.
+func (p Provider) Validate(name string) (err error) {
+	info, ok := providers[p]
+	if !ok {
+		return p.unknown()
+	}
+	if !info.pattern.MatchString(name) {
+		return fmt.Errorf("%w: %s project %q does not match %s format", ErrInvalidProjectName, info.DisplayName, name, info.NameFormat)
+	}
+	return nil
+}
+
+// Normalize returns the canonical form of a project name, with surrounding
+// spaces removed, letters lowercased for providers with case insensitive
+// names and provider specific corrections, like PEP 503 PyPI name
+// normalization, applied. The normalized name is validated.
+func (p Provider) Normalize(name string) (normalized string, err error) {
+	info, ok := providers[p]
+	if !ok {
+		return "", p.unknown()
+	}
+	normalized = strings.Trim(strings.TrimSpace(name), "/")
+	if info.normalize != nil {
+		normalized = info.normalize(normalized)
+	}
+	if err := p.Validate(normalized); err != nil {
+		return "", err
+	}
+	return normalized, nil
+}
+
+// unknown returns an error for the unknown provider, suggesting the most
+// similar known provider.
+func (p Provider) unknown() error {
+	var (
+		suggestion Provider
+		best       = 3
+	)
+	for known := range providers {
+		if d := levenshtein(strings.ToLower(string(p)), string(known)); d < best || d == best && known < suggestion {
+			suggestion, best = known, d
+		}
+	}
+	if suggestion != "" {
+		return fmt.Errorf("%w %q, did you mean %q", ErrUnknownProvider, p, suggestion)
+	}
+	return fmt.Errorf("%w %q", ErrUnknownProvider, p)
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases_test
+
+import (
+	"errors"
+	"testing"
+
+	"newreleases.io/newreleases"
+)
+
+func TestProvider_Info(t *testing.T) {
+	for _, p := range providersServiceListWant {
+		info, ok := newreleases.Provider(p).Info()
+		if !ok {
+			t.Errorf("provider %s: missing info", p)
+			continue
+		}
+		assertEqual(t, p+" provider", info.Provider, newreleases.Provider(p))
+		if info.DisplayName == "" || info.NameFormat == "" {
+			t.Errorf("provider %s: incomplete info %+v", p, info)
+		}
+	}
+
+	if _, ok := newreleases.Provider("unknown").Info(); ok {
+		t.Error("unknown provider has info")
+	}
+}
+
+func TestProviders(t *testing.T) {
+	infos := newreleases.Providers()
+	for i := 1; i < len(infos); i++ {
+		if infos[i-1].Provider >= infos[i].Provider {
+			t.Fatalf("providers not sorted: %s before %s", infos[i-1].Provider, infos[i].Provider)
+		}
+	}
+	github, _ := newreleases.ProviderGitHub.Info()
+	assertEqual(t, "github display name", github.DisplayName, "GitHub")
+	assertEqual(t, "github case sensitive", github.CaseSensitive, false)
+}
+
+func TestProvider_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		provider newreleases.Provider
+		name     string
+		valid    bool
+	}{
+		{provider: newreleases.ProviderGitHub, name: "golang/go", valid: true},
+		{provider: newreleases.ProviderGitHub, name: "golang", valid: false},
+		{provider: newreleases.ProviderGitHub, name: "github.com/golang/go", valid: false},
+		{provider: newreleases.ProviderGitLab, name: "group/subgroup/project", valid: true},
+		{provider: newreleases.ProviderNPM, name: "@angular/core", valid: true},
+		{provider: newreleases.ProviderNPM, name: "@Angular/core", valid: false},
+		{provider: newreleases.ProviderNPM, name: "react dom", valid: false},
+		{provider: newreleases.ProviderMaven, name: "org.apache.commons:commons-lang3", valid: true},
+		{provider: newreleases.ProviderMaven, name: "org.apache.commons/commons-lang3", valid: false},
+		{provider: newreleases.ProviderPyPI, name: "Django", valid: true},
+		{provider: newreleases.ProviderPyPI, name: "-django", valid: false},
+		{provider: newreleases.ProviderDockerHub, name: "library/nginx", valid: true},
+		{provider: newreleases.ProviderDockerHub, name: "nginx", valid: false},
+		{provider: newreleases.ProviderDockerHub, name: "Library/Nginx", valid: false},
+		{provider: newreleases.ProviderGo, name: "golang.org/x/net", valid: true},
+		{provider: newreleases.ProviderGo, name: "mymodule", valid: false},
+		{provider: newreleases.ProviderPackagist, name: "symfony/console", valid: true},
+		{provider: newreleases.ProviderPackagist, name: "Symfony/Console", valid: false},
+		{provider: newreleases.ProviderHex, name: "phoenix_live_view", valid: true},
+	} {
+		t.Run(string(tc.provider)+" "+tc.name, func(t *testing.T) {
+			err := tc.provider.Validate(tc.name)
+			if tc.valid {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if !errors.Is(err, newreleases.ErrInvalidProjectName) {
+				t.Fatalf("got error %v, want %v", err, newreleases.ErrInvalidProjectName)
+			}
+		})
+	}
+}
+
+func TestProvider_Validate_unknown(t *testing.T) {
+	for _, tc := range []struct {
+		provider newreleases.Provider
+		message  string
+	}{
+		{provider: "gihtub", message: `unknown provider "gihtub", did you mean "github"`},
+		{provider: "GitHub", message: `unknown provider "GitHub", did you mean "github"`},
+		{provider: "pipy", message: `unknown provider "pipy", did you mean "pypi"`},
+		{provider: "sourceforge", message: `unknown provider "sourceforge"`},
+	} {
+		t.Run(string(tc.provider), func(t *testing.T) {
+			err := tc.provider.Validate("name")
+			if !errors.Is(err, newreleases.ErrUnknownProvider) {
+				t.Fatalf("got error %v, want %v", err, newreleases.ErrUnknownProvider)
+			}
+			assertEqual(t, "message", err.Error(), tc.message)
+		})
+	}
+}
+
+func TestProvider_Normalize(t *testing.T) {
+	for _, tc := range []struct {
+		provider newreleases.Provider
+		name     string
+		want     string
+	}{
+		{provider: newreleases.ProviderGitHub, name: " Golang/Go/ ", want: "golang/go"},
+		{provider: newreleases.ProviderPyPI, name: "Django_Rest.Framework", want: "django-rest-framework"},
+		{provider: newreleases.ProviderDockerHub, name: "Nginx", want: "library/nginx"},
+		{provider: newreleases.ProviderMaven, name: "org.apache.commons/commons-lang3", want: "org.apache.commons:commons-lang3"},
+		{provider: newreleases.ProviderNPM, name: "JSONStream", want: "JSONStream"},
+		{provider: newreleases.ProviderGo, name: "github.com/BurntSushi/toml", want: "github.com/BurntSushi/toml"},
+		{provider: newreleases.ProviderPackagist, name: "Symfony/Console", want: "symfony/console"},
+	} {
+		t.Run(string(tc.provider)+" "+tc.name, func(t *testing.T) {
+			got, err := tc.provider.Normalize(tc.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "", got, tc.want)
+		})
+	}
+
+	if _, err := newreleases.ProviderGitHub.Normalize("golang"); !errors.Is(err, newreleases.ErrInvalidProjectName) {
+		t.Fatalf("got error %v, want %v", err, newreleases.ErrInvalidProjectName)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrPackageURLUnsupported is returned when a project provider has no package
+// URL type or a package URL type has no NewReleases provider.
+var ErrPackageURLUnsupported = errors.New("package url not supported")
+
+// packageURLTypes maps providers to package URL types. Providers that track
+// container images share the docker type and are distinguished by the
+// repository_url qualifier. Yarn packages are npm packages, so the yarn
+// provider maps to the npm type, which is parsed back as the npm provider.
+var packageURLTypes = map[Provider]string{
+	ProviderBitbucket: "bitbucket",
+	ProviderCargo:     "cargo",
+	ProviderCocoaPods: "cocoapods",
+	ProviderDockerHub: "docker",
+	ProviderGems:      "gem",
+	ProviderGHCR:      "docker",
+	ProviderGitHub:    "github",
+	ProviderGitLab:    "gitlab",
+	ProviderGo:        "golang",
+	ProviderHex:       "hex",
+	ProviderMaven:     "maven",
+	ProviderNPM:       "npm",
+	ProviderNuGet:     "nuget",
+	ProviderPackagist: "composer",
+	ProviderPub:       "pub",
+	ProviderPyPI:      "pypi",
+	ProviderQuay:      "docker",
+	ProviderYarn:      "npm",
+}
+
+// containerRegistries maps container image providers to their registry hosts.
+var containerRegistries = map[Provider]string{
+	ProviderDockerHub: "docker.io",
+	ProviderGHCR:      "ghcr.io",
+	ProviderQuay:      "quay.io",
+}
+
+// PackageURL returns the package URL (purl) that identifies the project, for
+// example pkg:github/golang/go or pkg:npm/%40angular/core. Package URLs of yarn
+// projects have the npm type, as there is no yarn type, so ParsePackageURL
+// returns them as npm projects.
+func (p *Project) PackageURL() (purl string, err error) {
+	return packageURL(p.Provider, p.Name, "")
+}
+
+// PackageURL returns the package URL (purl) that identifies the release of the
+// provided project, for example pkg:github/golang/go@go1.21.0.
+func (r *Release) PackageURL(p *Project) (purl string, err error) {
+	return packageURL(p.Provider, p.Name, r.Version)
+}
+
+func packageURL(provider Provider, name, version string) (purl string, err error) {
+	typ, ok := packageURLTypes[provider]
+	if !ok {
+		return "", fmt.Errorf("provider %s: %w", provider, ErrPackageURLUnsupported)
+	}
+
+	var qualifiers string
+	switch provider {
+	case ProviderMaven:
+		i := strings.LastIndex(name, ":")
+		if i < 0 {
+			return "", fmt.Errorf("invalid maven project name %q", name)
+		}
+		name = name[:i] + "/" + name[i+1:]
+	case ProviderDockerHub:
+		name = strings.TrimPrefix(name, "library/")
+	case ProviderGHCR, ProviderQuay:
+		qualifiers = "?repository_url=" + containerRegistries[provider]
+	}
+
+	segments := strings.Split(name, "/")
+	for i, s := range segments {
+		segments[i] = escapePackageURLComponent(s)
+	}
+	purl = "pkg:" + typ + "/" + strings.Join(segments, "/")
+	if version != "" {
+		purl += "@" + escapePackageURLComponent(version)
+	}
+	return purl + qualifiers, nil
+}
+
+// escapePackageURLComponent percent-encodes a package URL path segment or
+// version, including the @ character that separates the version.
+func escapePackageURLComponent(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "@", "%40")
+}
+
+// ParsePackageURL parses a package URL (purl) and returns the project with its
+// provider and name set. If the package URL contains a version, a release with
+// that version is returned, too, otherwise the release is nil.
+func ParsePackageURL(purl string) (project *Project, release *Release, err error) {
+	p, err := parsePackageURL(purl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		provider Provider
+		name     string
+	)
+	switch p.typ {
+	case "golang":
+		provider, name = ProviderGo, p.path()
+	case "npm":
+		provider, name = ProviderNPM, strings.ToLower(p.path())
+	case "pypi":
+		provider = ProviderPyPI
+		name, err = provider.Normalize(p.name)
+		if err != nil {
+			return nil, nil, err
+		}
+	case "maven":
+		if p.namespace == "" {
+			return nil, nil, fmt.Errorf("maven package url %q without namespace", purl)
+		}
+		provider, name = ProviderMaven, p.namespace+":"+p.name
+	case "composer":
+		provider, name = ProviderPackagist, strings.ToLower(p.path())
+	case "gem":
+		provider, name = ProviderGems, p.name
+	case "hex", "pub":
+		provider, name = Provider(p.typ), strings.ToLower(p.name)
+	case "cargo", "nuget", "cocoapods", "gitlab":
+		provider, name = Provider(p.typ), p.path()
+	case "github", "bitbucket":
+		provider, name = Provider(p.typ), strings.ToLower(p.path())
+	case "docker", "oci":
+		provider, name, err = containerImageProject(p)
+		if err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, fmt.Errorf("package url type %s: %w", p.typ, ErrPackageURLUnsupported)
+	}
+
+	project = &Project{
+		Provider: provider,
+		Name:     name,
+	}
+	if p.version != "" {
+		release = &Release{
+			Version: p.version,
+		}
+	}
+	return project, release, nil
+}
+
+// containerImageProject returns the provider and the name of a container image
+// from a docker or oci package URL. Docker package URLs have an optional
+// registry in the repository_url qualifier, while OCI ones have the complete
+// repository location in it.
+func containerImageProject(p packageURLComponents) (provider Provider, name string, err error) {
+	repository := p.qualifiers.Get("repository_url")
+	if p.typ == "oci" && repository == "" {
+		return "", "", fmt.Errorf("oci package url without repository: %w", ErrPackageURLUnsupported)
+	}
+	registry, name := "docker.io", p.path()
+	switch {
+	case p.typ == "oci":
+		registry, name = repository, ""
+		if i := strings.Index(repository, "/"); i >= 0 {
+			registry, name = repository[:i], repository[i+1:]
+		}
+	case repository != "":
+		registry = strings.TrimSuffix(repository, "/")
+	}
+
+	switch registry {
+	case "docker.io", "index.docker.io", "registry-1.docker.io", "hub.docker.com":
+		provider = ProviderDockerHub
+		if !strings.Contains(name, "/") {
+			name = "library/" + name
+		}
+	case "ghcr.io":
+		provider = ProviderGHCR
+	case "quay.io":
+		provider = ProviderQuay
+	default:
+		return "", "", fmt.Errorf("container registry %s: %w", registry, ErrPackageURLUnsupported)
+	}
+	if name == "" {
+		return "", "", fmt.Errorf("container image package url without name")
+	}
+	if provider != ProviderQuay {
+		name = strings.ToLower(name)
+	}
+	return provider, name, nil
+}
+
+type packageURLComponents struct {
+	typ        string
+	namespace  string
+	name       string
+	version    string
+	qualifiers url.Values
+}
+
+func (p packageURLComponents) path() string {
+	if p.namespace == "" {
+		return p.name
+	}
+	return p.namespace + "/" + p.name
+}
+
+// parsePackageURL splits a package URL into its components according to the
+// purl specification.
+func parsePackageURL(s string) (p packageURLComponents, err error) {
+	if !strings.HasPrefix(s, "pkg:") {
+		return p, fmt.Errorf("invalid package url %q: missing pkg scheme", s)
+	}
+	rest := strings.TrimLeft(strings.TrimPrefix(s, "pkg:"), "/")
+	if i := strings.Index(rest, "#"); i >= 0 {
+		rest = rest[:i]
+	}
+	if i := strings.Index(rest, "?"); i >= 0 {
+		q, err := url.ParseQuery(rest[i+1:])
+		if err != nil {
+			return p, fmt.Errorf("invalid package url %q: %w", s, err)
+		}
+		p.qualifiers = q
+		rest = rest[:i]
+	}
+	if i := strings.LastIndex(rest, "@"); i >= 0 && i > strings.LastIndex(rest, "/") {
+		v, err := url.PathUnescape(rest[i+1:])
+		if err != nil {
+			return p, fmt.Errorf("invalid package url %q: %w", s, err)
+		}
+		p.version = v
+		rest = rest[:i]
+	}
+	segments := strings.Split(strings.Trim(rest, "/"), "/")
+	if len(segments) < 2 {
+		return p, fmt.Errorf("invalid package url %q: missing name", s)
+	}
+	p.typ = strings.ToLower(segments[0])
+	for i, segment := range segments[1:] {
+		v, err := url.PathUnescape(segment)
+		if err != nil {
+			return p, fmt.Errorf("invalid package url %q: %w", s, err)
+		}
+		if v == "" {
+			return p, fmt.Errorf("invalid package url %q: empty path segment", s)
+		}
+		segments[i+1] = v
+	}
+	p.name = segments[len(segments)-1]
+	p.namespace = strings.Join(segments[1:len(segments)-1], "/")
+	return p, nil
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases_test
+
+import (
+	"errors"
+	"testing"
+
+	"newreleases.io/newreleases"
+)
+
+var packageURLTests = []struct {
+	provider newreleases.Provider
+	name     string
+	version  string
+	purl     string
+}{
+	{provider: "bitbucket", name: "atlassian/python-bitbucket", version: "0.1.0", purl: "pkg:bitbucket/atlassian/python-bitbucket@0.1.0"},
+	{provider: "cargo", name: "serde", version: "1.0.188", purl: "pkg:cargo/serde@1.0.188"},
+	{provider: "cocoapods", name: "AFNetworking", version: "4.0.1", purl: "pkg:cocoapods/AFNetworking@4.0.1"},
+	{provider: "dockerhub", name: "library/nginx", version: "1.25", purl: "pkg:docker/nginx@1.25"},
+	{provider: "dockerhub", name: "grafana/grafana", version: "10.1.0", purl: "pkg:docker/grafana/grafana@10.1.0"},
+	{provider: "gems", name: "rails", version: "7.0.8", purl: "pkg:gem/rails@7.0.8"},
+	{provider: "ghcr", name: "owner/image", version: "1.0", purl: "pkg:docker/owner/image@1.0?repository_url=ghcr.io"},
+	{provider: "github", name: "golang/go", version: "go1.21.0", purl: "pkg:github/golang/go@go1.21.0"},
+	{provider: "gitlab", name: "gitlab-org/gitlab-runner", version: "v16.4.0", purl: "pkg:gitlab/gitlab-org/gitlab-runner@v16.4.0"},
+	{provider: "go", name: "github.com/gorilla/mux", version: "v1.8.0", purl: "pkg:golang/github.com/gorilla/mux@v1.8.0"},
+	{provider: "hex", name: "phoenix", version: "1.7.7", purl: "pkg:hex/phoenix@1.7.7"},
+	{provider: "maven", name: "org.apache.commons:commons-lang3", version: "3.13.0", purl: "pkg:maven/org.apache.commons/commons-lang3@3.13.0"},
+	{provider: "npm", name: "@angular/core", version: "16.2.0", purl: "pkg:npm/%40angular/core@16.2.0"},
+	{provider: "nuget", name: "Newtonsoft.Json", version: "13.0.3", purl: "pkg:nuget/Newtonsoft.Json@13.0.3"},
+	{provider: "packagist", name: "symfony/console", version: "v6.3.4", purl: "pkg:composer/symfony/console@v6.3.4"},
+	{provider: "pub", name: "http", version: "1.1.0", purl: "pkg:pub/http@1.1.0"},
+	{provider: "pypi", name: "django", version: "4.2.5", purl: "pkg:pypi/django@4.2.5"},
+	{provider: "quay", name: "prometheus/prometheus", version: "v2.47.0", purl: "pkg:docker/prometheus/prometheus@v2.47.0?repository_url=quay.io"},
+}
+
+func TestProject_PackageURL(t *testing.T) {
+	for _, tc := range packageURLTests {
+		t.Run(tc.purl, func(t *testing.T) {
+			p := &newreleases.Project{Provider: tc.provider, Name: tc.name}
+
+			got, err := (&newreleases.Release{Version: tc.version}).PackageURL(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "release", got, tc.purl)
+
+			gotProject, gotRelease, err := newreleases.ParsePackageURL(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "parsed project", gotProject, p)
+			assertEqual(t, "parsed release", gotRelease, &newreleases.Release{Version: tc.version})
+
+			got, err = p.PackageURL()
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotProject, gotRelease, err = newreleases.ParsePackageURL(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "project parsed project", gotProject, p)
+			assertEqual(t, "project parsed release", gotRelease, (*newreleases.Release)(nil))
+		})
+	}
+}
+
+func TestProject_PackageURL_yarn(t *testing.T) {
+	got, err := (&newreleases.Project{Provider: "yarn", Name: "@babel/core"}).PackageURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertEqual(t, "", got, "pkg:npm/%40babel/core")
+
+	// There is no yarn package URL type, so the provider is not preserved.
+	project, _, err := newreleases.ParsePackageURL(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "parsed", project, &newreleases.Project{Provider: "npm", Name: "@babel/core"})
+}
+
+func TestProject_PackageURL_unsupported(t *testing.T) {
+	_, err := (&newreleases.Project{Provider: "codeberg", Name: "forgejo/forgejo"}).PackageURL()
+	if !errors.Is(err, newreleases.ErrPackageURLUnsupported) {
+		t.Fatalf("got error %v, want %v", err, newreleases.ErrPackageURLUnsupported)
+	}
+}
+
+func TestParsePackageURL(t *testing.T) {
+	for _, tc := range []struct {
+		purl    string
+		project *newreleases.Project
+		release *newreleases.Release
+	}{
+		{
+			purl:    "pkg:pypi/Django_Rest.Framework@3.14.0",
+			project: &newreleases.Project{Provider: "pypi", Name: "django-rest-framework"},
+			release: &newreleases.Release{Version: "3.14.0"},
+		},
+		{
+			purl:    "pkg:pypi/zope__interface",
+			project: &newreleases.Project{Provider: "pypi", Name: "zope-interface"},
+		},
+		{
+			purl:    "pkg:maven/org.apache.commons/commons-lang3@3.13.0?type=jar#sources",
+			project: &newreleases.Project{Provider: "maven", Name: "org.apache.commons:commons-lang3"},
+			release: &newreleases.Release{Version: "3.13.0"},
+		},
+		{
+			purl:    "pkg:oci/backup@sha256%3Aabcdef?repository_url=ghcr.io/example/backup",
+			project: &newreleases.Project{Provider: "ghcr", Name: "example/backup"},
+			release: &newreleases.Release{Version: "sha256:abcdef"},
+		},
+		{
+			purl:    "pkg:docker/nginx",
+			project: &newreleases.Project{Provider: "dockerhub", Name: "library/nginx"},
+		},
+	} {
+		t.Run(tc.purl, func(t *testing.T) {
+			project, release, err := newreleases.ParsePackageURL(tc.purl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "project", project, tc.project)
+			assertEqual(t, "release", release, tc.release)
+		})
+	}
+}
+
+func TestParsePackageURL_error(t *testing.T) {
+	for _, tc := range []struct {
+		purl        string
+		unsupported bool
+	}{
+		{purl: "https://github.com/golang/go"},
+		{purl: "pkg:github"},
+		{purl: "pkg:maven/commons-lang3@3.13.0"},
+		{purl: "pkg:npm/%zz"},
+		{purl: "pkg:pypi/-invalid-"},
+		{purl: "pkg:deb/debian/curl@7.88.1", unsupported: true},
+		{purl: "pkg:docker/distroless/static?repository_url=gcr.io", unsupported: true},
+		{purl: "pkg:oci/backup@sha256%3Aabcdef", unsupported: true},
+	} {
+		t.Run(tc.purl, func(t *testing.T) {
+			_, _, err := newreleases.ParsePackageURL(tc.purl)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			assertEqual(t, "unsupported", errors.Is(err, newreleases.ErrPackageURLUnsupported), tc.unsupported)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	semver "newreleases.io/newreleases/version"
+)
+
+// DefaultEndOfLifeAfter is the period without releases after which a release
+// line is considered end-of-life, if it is not set in ReleaseLinesOptions.
+const DefaultEndOfLifeAfter = 365 * 24 * time.Hour
+
+// ReleaseLinesOptions holds optional parameters for grouping releases into
+// release lines.
+type ReleaseLinesOptions struct {
+	// Minor groups releases by their major and minor versions, like 1.18,
+	// instead of by major versions only.
+	Minor bool
+	// EndOfLifeAfter is the period without releases after which a line is
+	// considered end-of-life. DefaultEndOfLifeAfter is used if it is zero.
+	EndOfLifeAfter time.Duration
+	// Now is the time from which the period without releases is measured.
+	// The current time is used if it is zero.
+	Now time.Time
+	// Prereleases includes releases marked as prereleases and versions with a
+	// prerelease part.
+	Prereleases bool
+	// SkipExcluded skips releases excluded by the project options.
+	SkipExcluded bool
+	// MaxPages limits the number of listed release pages in
+	// ReleasesService.ListLines. All pages are listed if it is zero.
+	MaxPages int
+}
+
+// ReleaseLine holds the newest release of a major or major.minor version line.
+type ReleaseLine struct {
+	// Name is the major version, like 18, or the major and minor versions,
+	// like 1.18, that the line is identified by.
+	Name string
+	// Major and Minor are the line version numbers. Minor is -1 for lines
+	// grouped by major versions only.
+	Major int
+	Minor int
+	// Latest is the release with the highest version in the line.
+	Latest Release
+	// LastReleased is the date of the most recent release in the line, which
+	// may differ from the date of the release with the highest version.
+	LastReleased time.Time
+	// Count is the number of releases in the line.
+	Count int
+	// EndOfLife is true if there were no releases in the line for the
+	// configured period.
+	EndOfLife bool
+}
+
+// Contains reports whether the version belongs to the line.
+func (l ReleaseLine) Contains(v semver.Version) bool {
+	return v.Major() == l.Major && (l.Minor < 0 || v.Minor() == l.Minor)
+}
+
+// ListLines returns the newest release for every major or major.minor version
+// line of a project referenced by its ID or by its provider and name. Lines
+// are sorted from the highest version to the lowest.
+func (s *ReleasesService) ListLines(ctx context.Context, ref ProjectRef, o *ReleaseLinesOptions) (lines []ReleaseLine, err error) {
+	maxPages := 0
+	if o != nil {
+		maxPages = o.MaxPages
+	}
+	releases, err := s.ListAll(ctx, ref, maxPages)
+	if err != nil {
+		return nil, err
+	}
+	return Releases(releases).Lines(o), nil
+}
+
+// Lines groups releases into major or major.minor version lines and returns
+// the newest release of every line, sorted from the highest version to the
+// lowest. Releases with versions that can not be parsed are ignored.
+func (rs Releases) Lines(o *ReleaseLinesOptions) (lines []ReleaseLine) {
+	if o == nil {
+		o = new(ReleaseLinesOptions)
+	}
+	eolAfter := o.EndOfLifeAfter
+	if eolAfter == 0 {
+		eolAfter = DefaultEndOfLifeAfter
+	}
+	now := o.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	type line struct {
+		ReleaseLine
+		version semver.Version
+	}
+	index := make(map[[2]int]*line)
+	for _, r := range rs {
+		if r.IsExcluded && o.SkipExcluded || r.IsPrerelease && !o.Prereleases {
+			continue
+		}
+		v, err := r.ParsedVersion()
+		if err != nil || v.IsPrerelease() && !o.Prereleases {
+			continue
+		}
+		key := [2]int{v.Major(), -1}
+		name := strconv.Itoa(v.Major())
+		if o.Minor {
+			key[1] = v.Minor()
+			name += "." + strconv.Itoa(v.Minor())
+		}
+		l, ok := index[key]
+		if !ok {
+			l = &line{
+				ReleaseLine: ReleaseLine{Name: name, Major: key[0], Minor: key[1], Latest: r},
+				version:     v,
+			}
+			index[key] = l
+		} else if v.GreaterThan(l.version) {
+			l.Latest, l.version = r, v
+		}
+		if r.Date.After(l.LastReleased) {
+			l.LastReleased = r.Date
+		}
+		l.Count++
+	}
+
+	for _, l := range index {
+		l.EndOfLife = now.Sub(l.LastReleased) > eolAfter
+		lines = append(lines, l.ReleaseLine)
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		if lines[i].Major != lines[j].Major {
+			return lines[i].Major > lines[j].Major
+		}
+		return lines[i].Minor > lines[j].Minor
+	})
+	return lines
+}
+
+// FindLine returns the line that the version belongs to.
+func FindLine(lines []ReleaseLine, version string) (line ReleaseLine, ok bool) {
+	v, err := semver.Parse(version)
+	if err != nil {
+		return ReleaseLine{}, false
+	}
+	for _, l := range lines {
+		if l.Contains(v) {
+			return l, true
+		}
+	}
+	return ReleaseLine{}, false
+}
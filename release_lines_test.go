@@ -0,0 +1,120 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"newreleases.io/newreleases"
+)
+
+func TestReleasesService_ListLines(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	mux.HandleFunc("/v1/projects/github/nodejs/node/releases", requireMethod("GET", newPagedStaticHandler(releasesServiceList...)))
+
+	got, err := client.Releases.ListLines(context.Background(), newreleases.ProjectRefByName(newreleases.ProviderGitHub, "nodejs/node"), &newreleases.ReleaseLinesOptions{
+		EndOfLifeAfter: 90 * 24 * time.Hour,
+		Now:            parseTime("2019-05-30T00:00:00Z"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertEqual(t, "", got, []newreleases.ReleaseLine{
+		{
+			Name:         "11",
+			Major:        11,
+			Minor:        -1,
+			Latest:       releasesServiceListWant[0][1],
+			LastReleased: parseTime("2019-03-06T19:44:17Z"),
+			Count:        1,
+		},
+		{
+			Name:         "10",
+			Major:        10,
+			Minor:        -1,
+			Latest:       releasesServiceListWant[0][2],
+			LastReleased: parseTime("2019-03-05T17:37:13Z"),
+			Count:        1,
+		},
+		{
+			Name:         "8",
+			Major:        8,
+			Minor:        -1,
+			Latest:       releasesServiceListWant[1][1],
+			LastReleased: parseTime("2019-02-28T11:20:35Z"),
+			Count:        1,
+			EndOfLife:    true,
+		},
+		{
+			Name:         "6",
+			Major:        6,
+			Minor:        -1,
+			Latest:       releasesServiceListWant[1][0],
+			LastReleased: parseTime("2019-02-28T11:23:55Z"),
+			Count:        1,
+			EndOfLife:    true,
+		},
+	})
+}
+
+func TestReleases_Lines(t *testing.T) {
+	releases := newreleases.Releases{
+		{Version: "1.21.0-rc.1", Date: parseTime("2023-07-14T00:00:00Z")},
+		{Version: "1.20.6", Date: parseTime("2023-07-11T00:00:00Z")},
+		{Version: "1.19.11", Date: parseTime("2023-07-11T00:00:00Z")},
+		{Version: "1.20.5", Date: parseTime("2023-06-06T00:00:00Z")},
+		{Version: "1.19.10", Date: parseTime("2023-06-06T00:00:00Z")},
+		{Version: "1.18.10", Date: parseTime("2023-01-10T00:00:00Z")},
+		{Version: "1.20.2", Date: parseTime("2023-07-20T00:00:00Z")},
+		{Version: "tip", Date: parseTime("2023-07-20T00:00:00Z")},
+	}
+	now := parseTime("2023-08-01T00:00:00Z")
+
+	t.Run("minor", func(t *testing.T) {
+		got := releases.Lines(&newreleases.ReleaseLinesOptions{
+			Minor:          true,
+			EndOfLifeAfter: 180 * 24 * time.Hour,
+			Now:            now,
+		})
+
+		assertEqual(t, "", got, []newreleases.ReleaseLine{
+			{Name: "1.20", Major: 1, Minor: 20, Latest: releases[1], LastReleased: releases[6].Date, Count: 3},
+			{Name: "1.19", Major: 1, Minor: 19, Latest: releases[2], LastReleased: releases[2].Date, Count: 2},
+			{Name: "1.18", Major: 1, Minor: 18, Latest: releases[5], LastReleased: releases[5].Date, Count: 1, EndOfLife: true},
+		})
+
+		line, ok := newreleases.FindLine(got, "go1.19.3")
+		assertEqual(t, "found", ok, true)
+		assertEqual(t, "found line", line.Name, "1.19")
+
+		_, ok = newreleases.FindLine(got, "1.17.1")
+		assertEqual(t, "not found", ok, false)
+	})
+
+	t.Run("prereleases", func(t *testing.T) {
+		got := releases.Lines(&newreleases.ReleaseLinesOptions{
+			Minor:       true,
+			Prereleases: true,
+			Now:         now,
+		})
+
+		assertEqual(t, "", got[0].Name, "1.21")
+		assertEqual(t, "", got[0].Latest, releases[0])
+	})
+
+	t.Run("major", func(t *testing.T) {
+		got := releases.Lines(&newreleases.ReleaseLinesOptions{Now: now})
+
+		assertEqual(t, "", got, []newreleases.ReleaseLine{
+			{Name: "1", Major: 1, Minor: -1, Latest: releases[1], LastReleased: releases[6].Date, Count: 6},
+		})
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases
+
+import (
+	"sort"
+
+	semver "newreleases.io/newreleases/version"
+)
+
+// ParsedVersion parses the release version.
+func (r Release) ParsedVersion() (v semver.Version, err error) {
+	return semver.Parse(r.Version)
+}
+
+// Releases is a list of releases with helpers for ordering them by version.
+// Releases with versions that can not be parsed are never matched by
+// constraints and are ordered before all others, by their dates.
+type Releases []Release
+
+// Sort sorts releases by their versions in ascending order.
+func (rs Releases) Sort() {
+	versions := rs.versions()
+	index := make([]int, len(rs))
+	for i := range index {
+		index[i] = i
+	}
+	sort.SliceStable(index, func(i, j int) bool {
+		return rs.less(versions, index[i], index[j])
+	})
+	sorted := make(Releases, len(rs))
+	for i, k := range index {
+		sorted[i] = rs[k]
+	}
+	copy(rs, sorted)
+}
+
+// Filter returns releases with versions that satisfy the constraint. As
+// described in semver.Constraint.Check, prerelease versions are matched only
+// if the constraint explicitly refers to them.
+func (rs Releases) Filter(c *semver.Constraint) (filtered Releases) {
+	for _, r := range rs {
+		v, err := r.ParsedVersion()
+		if err != nil {
+			continue
+		}
+		if c.Check(v) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// Max returns the release with the highest version. If none of the versions
+// can be parsed, the most recent release is returned. It returns false if
+// there are no releases.
+func (rs Releases) Max() (release Release, ok bool) {
+	if len(rs) == 0 {
+		return Release{}, false
+	}
+	versions := rs.versions()
+	max := 0
+	for i := 1; i < len(rs); i++ {
+		if rs.less(versions, max, i) {
+			max = i
+		}
+	}
+	return rs[max], true
+}
+
+func (rs Releases) versions() (versions []*semver.Version) {
+	versions = make([]*semver.Version, len(rs))
+	for i, r := range rs {
+		if v, err := r.ParsedVersion(); err == nil {
+			versions[i] = &v
+		}
+	}
+	return versions
+}
+
+func (rs Releases) less(versions []*semver.Version, i, j int) bool {
+	a, b := versions[i], versions[j]
+	switch {
+	case a == nil && b == nil:
+		return rs[i].Date.Before(rs[j].Date)
+	case a == nil:
+		return true
+	case b == nil:
+		return false
+	}
+	return a.LessThan(*b)
+}
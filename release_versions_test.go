@@ -0,0 +1,73 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newreleases_test
+
+import (
+	"testing"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/version"
+)
+
+func TestReleases_Sort(t *testing.T) {
+	releases := newreleases.Releases{
+		{Version: "v1.10.0"},
+		{Version: "nightly", Date: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{Version: "v1.2.0-rc.1"},
+		{Version: "v2.0.0"},
+		{Version: "latest", Date: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Version: "v1.2.0"},
+		{Version: "v1.9"},
+	}
+
+	releases.Sort()
+
+	assertEqual(t, "", versions(releases), []string{"latest", "nightly", "v1.2.0-rc.1", "v1.2.0", "v1.9", "v1.10.0", "v2.0.0"})
+}
+
+func TestReleases_Filter(t *testing.T) {
+	releases := newreleases.Releases{
+		{Version: "v2.1.0"},
+		{Version: "v1.18.3"},
+		{Version: "v1.19.0-rc.1"},
+		{Version: "v1.18.4"},
+		{Version: "latest"},
+		{Version: "v1.17.9"},
+	}
+
+	got := releases.Filter(version.MustParseConstraint("~1.18 || >=2"))
+
+	assertEqual(t, "", versions(got), []string{"v2.1.0", "v1.18.3", "v1.18.4"})
+}
+
+func TestReleases_Max(t *testing.T) {
+	t.Run("versions", func(t *testing.T) {
+		got, ok := newreleases.Releases{
+			{Version: "1.9.0"},
+			{Version: "edge"},
+			{Version: "1.10.0"},
+			{Version: "1.2.0"},
+		}.Max()
+		assertEqual(t, "ok", ok, true)
+		assertEqual(t, "version", got.Version, "1.10.0")
+	})
+
+	t.Run("unparsable versions", func(t *testing.T) {
+		got, ok := newreleases.Releases{
+			{Version: "edge", Date: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{Version: "stable", Date: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)},
+			{Version: "latest", Date: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)},
+		}.Max()
+		assertEqual(t, "ok", ok, true)
+		assertEqual(t, "version", got.Version, "stable")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, ok := newreleases.Releases(nil).Max()
+		assertEqual(t, "ok", ok, false)
+	})
+}
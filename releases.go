@@ -7,10 +7,13 @@ package newreleases
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/url"
 	"strconv"
 	"time"
+
+	semver "newreleases.io/newreleases/version"
 )
 
 // ReleasesService provides information about releases for every project that is
@@ -28,27 +31,27 @@ type Release struct {
 	HasNote      bool      `json:"has_note,omitempty"`
 }
 
-// ListByProjectID returns a paginated list of project releases and the number
-// of the last page. The project is referenced by its ID.
-func (s *ReleasesService) ListByProjectID(ctx context.Context, projectID string, page int) (releases []Release, lastPage int, err error) {
-	return s.list(ctx, projectID, page)
+// CVEURL returns the address of the CVE record with the identifier in the
+// National Vulnerability Database.
+func CVEURL(id string) string {
+	return "https://nvd.nist.gov/vuln/detail/" + url.PathEscape(id)
 }
 
-// ListByProjectName returns a paginated list of project releases and the number
-// of the last page. The project is referenced by its provider and name.
-func (s *ReleasesService) ListByProjectName(ctx context.Context, provider, projectName string, page int) (releases []Release, lastPage int, err error) {
-	return s.list(ctx, provider+"/"+projectName, page)
-}
-
-func (s *ReleasesService) list(ctx context.Context, projectRef string, page int) (releases []Release, lastPage int, err error) {
+// List returns a paginated list of project releases and the number of the last
+// page. The project is referenced by its ID or by its provider and name.
+func (s *ReleasesService) List(ctx context.Context, ref ProjectRef, page int) (releases []Release, lastPage int, err error) {
 
 	type releasesResponse struct {
 		Releases   []Release `json:"releases"`
 		TotalPages int       `json:"total_pages"`
 	}
 
+	projectPath, err := ref.path()
+	if err != nil {
+		return nil, 0, err
+	}
 	var r releasesResponse
-	path := "v1/projects/" + projectRef + "/releases"
+	path := projectPath + "/releases"
 	if page <= 0 {
 		return nil, 0, errInvalidPageNumber
 	}
@@ -59,38 +62,132 @@ func (s *ReleasesService) list(ctx context.Context, projectRef string, page int)
 	return r.Releases, r.TotalPages, err
 }
 
+// ListByProjectID returns a paginated list of project releases and the number
+// of the last page. The project is referenced by its ID.
+func (s *ReleasesService) ListByProjectID(ctx context.Context, projectID string, page int) (releases []Release, lastPage int, err error) {
+	return s.List(ctx, ProjectRefByID(projectID), page)
+}
+
+// ListByProjectName returns a paginated list of project releases and the number
+// of the last page. The project is referenced by its provider and name.
+func (s *ReleasesService) ListByProjectName(ctx context.Context, provider Provider, projectName string, page int) (releases []Release, lastPage int, err error) {
+	return s.List(ctx, ProjectRefByName(provider, projectName), page)
+}
+
+// Get returns a specific version release for a project referenced by its ID or
+// by its provider and name.
+func (s *ReleasesService) Get(ctx context.Context, ref ProjectRef, version string) (release *Release, err error) {
+	path, err := ref.path()
+	if err != nil {
+		return nil, err
+	}
+	err = s.client.request(ctx, http.MethodGet, path+"/releases/"+url.PathEscape(version), nil, &release)
+	return release, err
+}
+
 // GetByProjectID returns a specific version release for a project referenced by
 // its ID.
 func (s *ReleasesService) GetByProjectID(ctx context.Context, projectID, version string) (release *Release, err error) {
-	return s.get(ctx, projectID, version)
+	return s.Get(ctx, ProjectRefByID(projectID), version)
 }
 
 // GetByProjectName returns a specific version release for a project referenced
 // by its provider and name.
-func (s *ReleasesService) GetByProjectName(ctx context.Context, provider, projectName, version string) (release *Release, err error) {
-	return s.get(ctx, provider+"/"+projectName, version)
+func (s *ReleasesService) GetByProjectName(ctx context.Context, provider Provider, projectName, version string) (release *Release, err error) {
+	return s.Get(ctx, ProjectRefByName(provider, projectName), version)
 }
 
-func (s *ReleasesService) get(ctx context.Context, projectRef, version string) (release *Release, err error) {
-	err = s.client.request(ctx, http.MethodGet, "v1/projects/"+projectRef+"/releases/"+url.PathEscape(version), nil, &release)
+// GetLatest returns the latest non-excluded version release for a project
+// referenced by its ID or by its provider and name.
+func (s *ReleasesService) GetLatest(ctx context.Context, ref ProjectRef) (release *Release, err error) {
+	path, err := ref.path()
+	if err != nil {
+		return nil, err
+	}
+	err = s.client.request(ctx, http.MethodGet, path+"/latest-release", nil, &release)
 	return release, err
 }
 
 // GetLatestByProjectID returns the latest non-excluded version release for a project referenced by
 // its ID.
 func (s *ReleasesService) GetLatestByProjectID(ctx context.Context, projectID string) (release *Release, err error) {
-	return s.getLatest(ctx, projectID)
+	return s.GetLatest(ctx, ProjectRefByID(projectID))
 }
 
 // GetLatestByProjectName returns the latest non-excluded version release for a project referenced
 // by its provider and name.
-func (s *ReleasesService) GetLatestByProjectName(ctx context.Context, provider, projectName string) (release *Release, err error) {
-	return s.getLatest(ctx, provider+"/"+projectName)
+func (s *ReleasesService) GetLatestByProjectName(ctx context.Context, provider Provider, projectName string) (release *Release, err error) {
+	return s.GetLatest(ctx, ProjectRefByName(provider, projectName))
 }
 
-func (s *ReleasesService) getLatest(ctx context.Context, projectRef string) (release *Release, err error) {
-	err = s.client.request(ctx, http.MethodGet, "v1/projects/"+projectRef+"/latest-release", nil, &release)
-	return release, err
+// ErrNoMatchingRelease is returned by ReleasesService.GetLatestMatching if
+// none of the project releases match the constraint.
+var ErrNoMatchingRelease = errors.New("no matching release")
+
+// LatestMatchingOptions holds optional parameters for
+// ReleasesService.GetLatestMatching.
+type LatestMatchingOptions struct {
+	// Prereleases allows matching releases marked as prereleases and versions
+	// with a prerelease part. If it is false, releases marked as prereleases
+	// are always skipped, and a version with a prerelease part is matched only
+	// if the constraint refers to a prerelease of the same version.
+	Prereleases bool
+	// SkipUpdated skips releases marked as updated, which are re-releases of
+	// already published versions.
+	SkipUpdated bool
+	// SkipExcluded skips releases excluded by the project options.
+	SkipExcluded bool
+	// MaxPages limits the number of listed release pages. All pages are listed
+	// if it is zero.
+	MaxPages int
+}
+
+// GetLatestMatching returns the release with the highest version that
+// satisfies the constraint, like the latest 2.x or 1.18.x release, for a
+// project referenced by its ID or by its provider and name. As releases are
+// listed by date, all release pages are walked, up to MaxPages in options.
+// Releases with versions that can not be parsed are not matched. If the
+// constraint is nil, the release with the highest version is returned.
+func (s *ReleasesService) GetLatestMatching(ctx context.Context, ref ProjectRef, constraint *semver.Constraint, o *LatestMatchingOptions) (release *Release, err error) {
+	if o == nil {
+		o = new(LatestMatchingOptions)
+	}
+	var latest *semver.Version
+	err = s.Walk(ctx, ref, o.MaxPages, func(r Release) (stop bool, err error) {
+		if r.IsUpdated && o.SkipUpdated || r.IsExcluded && o.SkipExcluded || r.IsPrerelease && !o.Prereleases {
+			return false, nil
+		}
+		v, err := r.ParsedVersion()
+		if err != nil {
+			return false, nil
+		}
+		switch {
+		case constraint == nil:
+			if v.IsPrerelease() && !o.Prereleases {
+				return false, nil
+			}
+		case o.Prereleases:
+			if !constraint.Contains(v) {
+				return false, nil
+			}
+		default:
+			if !constraint.Check(v) {
+				return false, nil
+			}
+		}
+		if latest == nil || v.GreaterThan(*latest) {
+			r := r
+			release, latest = &r, &v
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if release == nil {
+		return nil, ErrNoMatchingRelease
+	}
+	return release, nil
 }
 
 // ReleaseNote holds information about an additional note for a specific
@@ -101,19 +198,74 @@ type ReleaseNote struct {
 	URL     string `json:"url,omitempty"`
 }
 
+// GetNote returns a specific release note for a project referenced by its ID
+// or by its provider and name.
+func (s *ReleasesService) GetNote(ctx context.Context, ref ProjectRef, version string) (note *ReleaseNote, err error) {
+	path, err := ref.path()
+	if err != nil {
+		return nil, err
+	}
+	err = s.client.request(ctx, http.MethodGet, path+"/releases/"+url.PathEscape(version)+"/note", nil, &note)
+	return note, err
+}
+
 // GetNoteByProjectID returns a specific release note for a project referenced
 // by its ID.
 func (s *ReleasesService) GetNoteByProjectID(ctx context.Context, projectID string, version string) (release *ReleaseNote, err error) {
-	return s.getNote(ctx, projectID, version)
+	return s.GetNote(ctx, ProjectRefByID(projectID), version)
 }
 
 // GetNoteByProjectName returns a specific release note for a project referenced
 // by its provider and name.
-func (s *ReleasesService) GetNoteByProjectName(ctx context.Context, provider, projectName string, version string) (release *ReleaseNote, err error) {
-	return s.getNote(ctx, provider+"/"+projectName, version)
+func (s *ReleasesService) GetNoteByProjectName(ctx context.Context, provider Provider, projectName string, version string) (release *ReleaseNote, err error) {
+	return s.GetNote(ctx, ProjectRefByName(provider, projectName), version)
 }
 
-func (s *ReleasesService) getNote(ctx context.Context, projectRef, version string) (note *ReleaseNote, err error) {
-	err = s.client.request(ctx, http.MethodGet, "v1/projects/"+projectRef+"/releases/"+url.PathEscape(version)+"/note", nil, &note)
-	return note, err
+// ListAll returns releases of a project from all pages, or only from the
+// first maxPages pages if maxPages is greater than zero. The project is
+// referenced by its ID or by its provider and name.
+func (s *ReleasesService) ListAll(ctx context.Context, ref ProjectRef, maxPages int) (releases []Release, err error) {
+	err = s.Walk(ctx, ref, maxPages, func(r Release) (stop bool, err error) {
+		releases = append(releases, r)
+		return false, nil
+	})
+	return releases, err
+}
+
+// Walk calls the function for every release of a project, page by page, until
+// the last page or maxPages pages are listed, or the function returns true or
+// an error. All pages are listed if maxPages is zero.
+func (s *ReleasesService) Walk(ctx context.Context, ref ProjectRef, maxPages int, fn func(r Release) (stop bool, err error)) (err error) {
+	return s.WalkSince(ctx, ref, time.Time{}, maxPages, fn)
+}
+
+// WalkSince calls the function for every release of a project published on or
+// after since, like Walk. As releases are listed from the most recent, pages
+// are listed only until a page with all releases published before since. All
+// releases are walked if since is zero.
+func (s *ReleasesService) WalkSince(ctx context.Context, ref ProjectRef, since time.Time, maxPages int, fn func(r Release) (stop bool, err error)) (err error) {
+	for page := 1; maxPages <= 0 || page <= maxPages; page++ {
+		releases, lastPage, err := s.List(ctx, ref, page)
+		if err != nil {
+			return err
+		}
+		older := !since.IsZero()
+		for _, r := range releases {
+			if !since.IsZero() && r.Date.Before(since) {
+				continue
+			}
+			older = false
+			stop, err := fn(r)
+			if err != nil {
+				return err
+			}
+			if stop {
+				return nil
+			}
+		}
+		if page >= lastPage || len(releases) == 0 || older {
+			break
+		}
+	}
+	return nil
 }
@@ -7,11 +7,14 @@ package newreleases_test
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"strconv"
 	"testing"
 	"time"
 
 	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/version"
 )
 
 func TestReleasesService_ListByProjectID(t *testing.T) {
@@ -246,3 +249,168 @@ func parseTime(s string) (t time.Time) {
 	}
 	return t
 }
+
+func TestReleasesService_ListAll(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	mux.HandleFunc("/v1/projects/github/nodejs/node/releases", requireMethod("GET", newPagedStaticHandler(releasesServiceList...)))
+
+	ref := newreleases.ProjectRefByName(newreleases.ProviderGitHub, "nodejs/node")
+
+	got, err := client.Releases.ListAll(context.Background(), ref, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "all pages", got, append(append([]newreleases.Release(nil), releasesServiceListWant[0]...), releasesServiceListWant[1]...))
+
+	got, err = client.Releases.ListAll(context.Background(), ref, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "first page", got, releasesServiceListWant[0])
+}
+
+func TestReleasesService_WalkSince(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	var pages []string
+	mux.HandleFunc("/v1/projects/github/nodejs/node/releases", requireMethod("GET", func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("page"))
+		newPagedStaticHandler(releasesServiceList...)(w, r)
+	}))
+
+	ref := newreleases.ProjectRefByName(newreleases.ProviderGitHub, "nodejs/node")
+
+	for _, tc := range []struct {
+		name  string
+		since time.Time
+		want  []string
+		pages []string
+	}{
+		{
+			name:  "first page",
+			since: parseTime("2019-03-06T00:00:00Z"),
+			want:  []string{"v11.12.0", "v11.11.0"},
+			pages: []string{"", "2"},
+		},
+		{
+			name:  "older page",
+			since: parseTime("2019-03-01T00:00:00Z"),
+			want:  []string{"v11.12.0", "v11.11.0", "v10.15.3"},
+			pages: []string{"", "2"},
+		},
+		{
+			name:  "all pages",
+			want:  []string{"v11.12.0", "v11.11.0", "v10.15.3", "v6.17.0", "v8.15.1"},
+			pages: []string{"", "2"},
+		},
+		{
+			name:  "future",
+			since: parseTime("2020-01-01T00:00:00Z"),
+			pages: []string{""},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pages = nil
+			var got []string
+			err := client.Releases.WalkSince(context.Background(), ref, tc.since, 0, func(r newreleases.Release) (stop bool, err error) {
+				got = append(got, r.Version)
+				return false, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, "versions", got, tc.want)
+			assertEqual(t, "pages", pages, tc.pages)
+		})
+	}
+}
+
+func TestReleasesService_GetLatestMatching(t *testing.T) {
+	client, mux, _, teardown := newClient(t, "")
+	defer teardown()
+
+	mux.HandleFunc("/v1/projects/github/nodejs/node/releases", requireMethod("GET", newPagedStaticHandler(releasesServiceList...)))
+
+	ref := newreleases.ProjectRefByName(newreleases.ProviderGitHub, "nodejs/node")
+
+	for _, tc := range []struct {
+		name       string
+		constraint string
+		options    *newreleases.LatestMatchingOptions
+		want       string
+		err        error
+	}{
+		{
+			name: "no constraint",
+			want: "v11.11.0",
+		},
+		{
+			name:       "major line",
+			constraint: "10.x",
+			want:       "v10.15.3",
+		},
+		{
+			name:       "lower than",
+			constraint: "<11",
+			want:       "v10.15.3",
+		},
+		{
+			name:       "prereleases",
+			constraint: ">=11",
+			options:    &newreleases.LatestMatchingOptions{Prereleases: true},
+			want:       "v11.12.0",
+		},
+		{
+			name:       "marked prerelease",
+			constraint: ">=11.12.0-rc.1",
+			err:        newreleases.ErrNoMatchingRelease,
+		},
+		{
+			name:       "skip updated",
+			constraint: "^11",
+			options:    &newreleases.LatestMatchingOptions{SkipUpdated: true},
+			err:        newreleases.ErrNoMatchingRelease,
+		},
+		{
+			name:       "excluded",
+			constraint: "^6",
+			want:       "v6.17.0",
+		},
+		{
+			name:       "skip excluded",
+			constraint: "^6",
+			options:    &newreleases.LatestMatchingOptions{SkipExcluded: true},
+			err:        newreleases.ErrNoMatchingRelease,
+		},
+		{
+			name:       "second page",
+			constraint: "~8.15",
+			want:       "v8.15.1",
+		},
+		{
+			name:       "max pages",
+			constraint: "~8.15",
+			options:    &newreleases.LatestMatchingOptions{MaxPages: 1},
+			err:        newreleases.ErrNoMatchingRelease,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var constraint *version.Constraint
+			if tc.constraint != "" {
+				constraint = version.MustParseConstraint(tc.constraint)
+			}
+
+			got, err := client.Releases.GetLatestMatching(context.Background(), ref, constraint, tc.options)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("got error %v, want %v", err, tc.err)
+			}
+			if tc.err != nil {
+				return
+			}
+			assertEqual(t, "version", got.Version, tc.want)
+		})
+	}
+}
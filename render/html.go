@@ -0,0 +1,431 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// node is an element or a text node of a parsed HTML tree. Text nodes have an
+// empty tag.
+type node struct {
+	tag      string
+	text     string
+	attrs    []attr
+	children []*node
+}
+
+type attr struct {
+	name, value string
+}
+
+func (n *node) attr(name string) string {
+	for _, a := range n.attrs {
+		if a.name == name {
+			return a.value
+		}
+	}
+	return ""
+}
+
+// textContent returns the text of all descendant text nodes.
+func (n *node) textContent() string {
+	if n.tag == "" {
+		return n.text
+	}
+	var b strings.Builder
+	for _, c := range n.children {
+		b.WriteString(c.textContent())
+	}
+	return b.String()
+}
+
+var (
+	voidElements = toSet("area", "base", "br", "col", "embed", "hr", "img", "input", "link", "meta", "param", "source", "track", "wbr")
+	// rawTextElements contain text that is not parsed for tags.
+	rawTextElements = toSet("script", "style", "textarea", "title", "xmp", "iframe", "noembed", "noframes", "noscript", "plaintext")
+	// droppedElements are removed from the output together with their content.
+	droppedElements = toSet("applet", "base", "button", "embed", "frame", "frameset", "head", "iframe", "input", "link", "math", "meta", "noembed", "noframes", "noscript", "object", "param", "plaintext", "script", "select", "style", "svg", "template", "textarea", "title", "xmp")
+	// closesParagraph are elements that implicitly close an open paragraph.
+	closesParagraph = toSet("address", "article", "aside", "blockquote", "details", "div", "dl", "fieldset", "figure", "footer", "form", "h1", "h2", "h3", "h4", "h5", "h6", "header", "hr", "main", "nav", "ol", "p", "pre", "section", "table", "ul")
+)
+
+func toSet(s ...string) map[string]bool {
+	m := make(map[string]bool, len(s))
+	for _, v := range s {
+		m[v] = true
+	}
+	return m
+}
+
+// parseHTML parses an HTML document or fragment into a tree. It is tolerant
+// to invalid markup: unknown end tags are ignored, and elements that are not
+// closed are closed at the end of their parent.
+func parseHTML(s string) (root *node) {
+	root = &node{tag: "#root"}
+	stack := []*node{root}
+	current := func() *node { return stack[len(stack)-1] }
+
+	// closeElement closes the innermost open element with the name, unless
+	// one of the boundary elements is open inside it.
+	closeElement := func(name string, boundary ...string) bool {
+		for i := len(stack) - 1; i > 0; i-- {
+			if stack[i].tag == name {
+				stack = stack[:i]
+				return true
+			}
+			for _, b := range boundary {
+				if stack[i].tag == b {
+					return false
+				}
+			}
+		}
+		return false
+	}
+
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			appendText(current(), html.UnescapeString(s))
+			break
+		}
+		if i > 0 {
+			appendText(current(), html.UnescapeString(s[:i]))
+			s = s[i:]
+		}
+
+		switch {
+		case strings.HasPrefix(s, "<!--"):
+			end := strings.Index(s[4:], "-->")
+			if end < 0 {
+				return root
+			}
+			s = s[4+end+3:]
+		case strings.HasPrefix(s, "<!"), strings.HasPrefix(s, "<?"):
+			end := strings.IndexByte(s, '>')
+			if end < 0 {
+				return root
+			}
+			s = s[end+1:]
+		case strings.HasPrefix(s, "</"):
+			name, _ := tagName(s[2:])
+			if name == "" {
+				appendText(current(), "<")
+				s = s[1:]
+				continue
+			}
+			end := strings.IndexByte(s, '>')
+			if end < 0 {
+				return root
+			}
+			s = s[end+1:]
+			switch name {
+			case "li":
+				closeElement(name, "ul", "ol")
+			case "td", "th", "tr":
+				closeElement(name, "table")
+			default:
+				closeElement(name)
+			}
+		default:
+			name, attrs, selfClosing, n := startTag(s)
+			if name == "" {
+				appendText(current(), "<")
+				s = s[1:]
+				continue
+			}
+			s = s[n:]
+
+			if closesParagraph[name] {
+				closeElement("p", "blockquote", "button", "dd", "div", "li", "td", "th")
+			}
+			switch name {
+			case "li":
+				closeElement("li", "ul", "ol")
+			case "dt", "dd":
+				if !closeElement("dt", "dl") {
+					closeElement("dd", "dl")
+				}
+			case "tr":
+				closeElement("tr", "table")
+			case "td", "th":
+				if !closeElement("td", "tr", "table") {
+					closeElement("th", "tr", "table")
+				}
+			}
+
+			e := &node{tag: name, attrs: attrs}
+			parent := current()
+			parent.children = append(parent.children, e)
+			if rawTextElements[name] {
+				end := indexFold(s, "</"+name)
+				if end < 0 {
+					end = len(s)
+				}
+				text := s[:end]
+				if name == "textarea" || name == "title" {
+					text = html.UnescapeString(text)
+				}
+				appendText(e, text)
+				s = s[end:]
+				if gt := strings.IndexByte(s, '>'); gt >= 0 {
+					s = s[gt+1:]
+				}
+				continue
+			}
+			if !voidElements[name] && !selfClosing {
+				stack = append(stack, e)
+			}
+		}
+	}
+	return root
+}
+
+func appendText(n *node, text string) {
+	if text == "" {
+		return
+	}
+	if l := len(n.children); l > 0 && n.children[l-1].tag == "" {
+		n.children[l-1].text += text
+		return
+	}
+	n.children = append(n.children, &node{text: text})
+}
+
+// tagName returns the lowercased tag name at the start of the string and its
+// length.
+func tagName(s string) (name string, n int) {
+	if len(s) == 0 || !isASCIILetter(s[0]) {
+		return "", 0
+	}
+	for n < len(s) && (isASCIILetter(s[n]) || s[n] >= '0' && s[n] <= '9' || s[n] == '-') {
+		n++
+	}
+	return strings.ToLower(s[:n]), n
+}
+
+// startTag parses a start tag at the start of the string and returns its
+// name, attributes, whether it is self-closing and its length. The returned
+// name is empty if the string does not start with a valid start tag.
+func startTag(s string) (name string, attrs []attr, selfClosing bool, n int) {
+	name, n = tagName(s[1:])
+	if name == "" {
+		return "", nil, false, 0
+	}
+	n++
+	for {
+		for n < len(s) && isSpace(s[n]) {
+			n++
+		}
+		if n >= len(s) {
+			return "", nil, false, 0
+		}
+		switch s[n] {
+		case '>':
+			return name, attrs, selfClosing, n + 1
+		case '/':
+			selfClosing = true
+			n++
+			continue
+		}
+		selfClosing = false
+
+		start := n
+		for n < len(s) && !isSpace(s[n]) && s[n] != '=' && s[n] != '>' && s[n] != '/' {
+			n++
+		}
+		a := attr{name: strings.ToLower(s[start:n])}
+		for n < len(s) && isSpace(s[n]) {
+			n++
+		}
+		if n < len(s) && s[n] == '=' {
+			n++
+			for n < len(s) && isSpace(s[n]) {
+				n++
+			}
+			if n < len(s) && (s[n] == '"' || s[n] == '\'') {
+				end := strings.IndexByte(s[n+1:], s[n])
+				if end < 0 {
+					return "", nil, false, 0
+				}
+				a.value = s[n+1 : n+1+end]
+				n += end + 2
+			} else {
+				start := n
+				for n < len(s) && !isSpace(s[n]) && s[n] != '>' {
+					n++
+				}
+				a.value = s[start:n]
+			}
+			a.value = html.UnescapeString(a.value)
+		}
+		if a.name != "" {
+			attrs = append(attrs, a)
+		}
+	}
+}
+
+func indexFold(s, substr string) int {
+	return strings.Index(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// allowedElements are written to the sanitized HTML with their allowed
+// attributes. Other elements, except the dropped ones, are replaced by their
+// content.
+var allowedElements = map[string][]string{
+	"a":          {"href", "title"},
+	"abbr":       {"title"},
+	"b":          nil,
+	"blockquote": nil,
+	"br":         nil,
+	"code":       {"class"},
+	"dd":         nil,
+	"del":        nil,
+	"details":    nil,
+	"div":        nil,
+	"dl":         nil,
+	"dt":         nil,
+	"em":         nil,
+	"h1":         nil,
+	"h2":         nil,
+	"h3":         nil,
+	"h4":         nil,
+	"h5":         nil,
+	"h6":         nil,
+	"hr":         nil,
+	"i":          nil,
+	"img":        {"src", "alt", "title", "width", "height"},
+	"ins":        nil,
+	"kbd":        nil,
+	"li":         nil,
+	"mark":       nil,
+	"ol":         {"start"},
+	"p":          nil,
+	"pre":        nil,
+	"q":          nil,
+	"s":          nil,
+	"samp":       nil,
+	"small":      nil,
+	"span":       nil,
+	"strike":     nil,
+	"strong":     nil,
+	"sub":        nil,
+	"summary":    nil,
+	"sup":        nil,
+	"table":      nil,
+	"tbody":      nil,
+	"td":         {"colspan", "rowspan", "align"},
+	"tfoot":      nil,
+	"th":         {"colspan", "rowspan", "align"},
+	"thead":      nil,
+	"tr":         nil,
+	"tt":         nil,
+	"u":          nil,
+	"ul":         nil,
+	"var":        nil,
+}
+
+var (
+	classPattern  = regexp.MustCompile(`^language-[\w+-]+$`)
+	numberPattern = regexp.MustCompile(`^\d{1,4}$`)
+)
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// writeHTML writes the sanitized HTML of the node children.
+func writeHTML(b *strings.Builder, n *node, base *url.URL) {
+	for _, c := range n.children {
+		if c.tag == "" {
+			b.WriteString(textEscaper.Replace(c.text))
+			continue
+		}
+		if droppedElements[c.tag] {
+			continue
+		}
+		allowed, ok := allowedElements[c.tag]
+		if !ok {
+			writeHTML(b, c, base)
+			continue
+		}
+
+		var attrs []attr
+		for _, a := range c.attrs {
+			if !contains(allowed, a.name) {
+				continue
+			}
+			value := a.value
+			switch a.name {
+			case "href", "src":
+				var ok bool
+				if value, ok = resolveURL(value, base); !ok {
+					continue
+				}
+			case "class":
+				if !classPattern.MatchString(value) {
+					continue
+				}
+			case "width", "height", "colspan", "rowspan", "start":
+				if !numberPattern.MatchString(value) {
+					continue
+				}
+			case "align":
+				if value != "left" && value != "center" && value != "right" {
+					continue
+				}
+			}
+			attrs = append(attrs, attr{name: a.name, value: value})
+		}
+
+		switch {
+		case c.tag == "a" && !hasAttr(attrs, "href"):
+			// Links without safe addresses are replaced by their content.
+			writeHTML(b, c, base)
+			continue
+		case c.tag == "img" && !hasAttr(attrs, "src"):
+			continue
+		}
+
+		b.WriteString("<" + c.tag)
+		for _, a := range attrs {
+			b.WriteString(" " + a.name + `="` + html.EscapeString(a.value) + `"`)
+		}
+		b.WriteString(">")
+		if voidElements[c.tag] {
+			continue
+		}
+		writeHTML(b, c, base)
+		b.WriteString("</" + c.tag + ">")
+	}
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAttr(attrs []attr, name string) bool {
+	for _, a := range attrs {
+		if a.name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render_test
+
+import (
+	"testing"
+
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/render"
+)
+
+func TestDocument_HTML_sanitize(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "script",
+			in:   `<p>safe<script>alert("<p>x</p>")</script></p><SCRIPT src="x.js"></SCRIPT>`,
+			want: `<p>safe</p>`,
+		},
+		{
+			name: "style and iframe",
+			in:   `<style>p { color: red }</style><iframe src="https://evil.example"></iframe><p>text</p>`,
+			want: `<p>text</p>`,
+		},
+		{
+			name: "event handlers and styles",
+			in:   `<p onclick="evil()" style="color: red" class="x">text</p>`,
+			want: `<p>text</p>`,
+		},
+		{
+			name: "javascript links",
+			in:   `<a href="javascript:alert(1)">one</a> <a href=" JaVaScRiPt:alert(1)">two</a> <a href="jav&#x09;ascript:alert(1)">three</a>`,
+			want: `one two three`,
+		},
+		{
+			name: "data images",
+			in:   `<img src="data:image/svg+xml;base64,AAAA" alt="x"><img src="https://example.com/a.png" onerror="evil()" alt="a">`,
+			want: `<img src="https://example.com/a.png" alt="a">`,
+		},
+		{
+			name: "relative links",
+			in:   `<a href="v1.0.0">previous</a> <img src="/logo.png" alt="logo" width="10" height="x">`,
+			want: `<a href="https://example.com/releases/v1.0.0">previous</a> <img src="https://example.com/logo.png" alt="logo" width="10">`,
+		},
+		{
+			name: "mailto links",
+			in:   `<a href="mailto:security@example.com" title="Report">report</a>`,
+			want: `<a href="mailto:security@example.com" title="Report">report</a>`,
+		},
+		{
+			name: "unknown elements",
+			in:   `<html><body><font color="red"><center>text</center></font></body></html>`,
+			want: `text`,
+		},
+		{
+			name: "forms",
+			in:   `<form action="https://evil.example"><input name="password"><textarea><b>x</b></textarea><button>Go</button></form>`,
+			want: ``,
+		},
+		{
+			name: "code language",
+			in:   `<pre><code class="language-go">x := 1</code></pre><code class="evil">y</code>`,
+			want: `<pre><code class="language-go">x := 1</code></pre><code>y</code>`,
+		},
+		{
+			name: "comments and doctype",
+			in:   `<!DOCTYPE html><!-- <script>alert(1)</script> --><p>text</p>`,
+			want: `<p>text</p>`,
+		},
+		{
+			name: "unclosed elements",
+			in:   `<ul><li>one<li>two</ul><p>first<p>second<table><tr><td>a<td>b<tr><td>c</table>`,
+			want: `<ul><li>one</li><li>two</li></ul><p>first</p><p>second</p><table><tr><td>a</td><td>b</td></tr><tr><td>c</td></tr></table>`,
+		},
+		{
+			name: "stray end tags",
+			in:   `</div>text</b><b>bold`,
+			want: `text<b>bold</b>`,
+		},
+		{
+			name: "entities",
+			in:   `<p title="x">a &amp; b &lt;c&gt; &quot;d&quot; &copy;</p>`,
+			want: `<p>a &amp; b &lt;c&gt; "d" ©</p>`,
+		},
+		{
+			name: "invalid tags",
+			in:   `a < b and 1<2 <3 <>`,
+			want: `a &lt; b and 1&lt;2 &lt;3 &lt;&gt;`,
+		},
+		{
+			name: "attribute quoting",
+			in:   `<a href='https://example.com/?a=1&amp;b="2"' title=unquoted>link</a>`,
+			want: `<a href="https://example.com/?a=1&amp;b=&#34;2&#34;" title="unquoted">link</a>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := render.ParseFormat(tc.in, render.FormatHTML, "https://example.com/releases/v1.1.0").HTML()
+			testutil.AssertEqual(t, "", got, tc.want)
+		})
+	}
+}
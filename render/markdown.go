@@ -0,0 +1,730 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	atxHeadingPattern    = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	thematicBreakPattern = regexp.MustCompile(`^ {0,3}(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	fencePattern         = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^`]*)$")
+	listItemPattern      = regexp.MustCompile(`^( {0,3})([-*+]|\d{1,9}[.)])(?:([ \t]+)(.*))?$`)
+	htmlBlockPattern     = regexp.MustCompile(`^ {0,3}(?:<!--|</?[a-zA-Z][a-zA-Z0-9-]*(?:[ \t/>]|$))`)
+	tableDelimiterRow    = regexp.MustCompile(`^ {0,3}\|?[ \t]*:?-+:?[ \t]*(?:\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+	setextPattern        = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+)
+
+// markdownToHTML converts a subset of CommonMark with GitHub tables,
+// strikethrough and autolinks to HTML. HTML blocks and inline tags are passed
+// through to be sanitized later.
+func markdownToHTML(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\t", "    ")
+	var b strings.Builder
+	markdownBlocks(&b, strings.Split(s, "\n"), false)
+	return b.String()
+}
+
+// markdownBlocks writes HTML for block level elements in the lines. Paragraphs
+// are written without p elements in tight list items.
+func markdownBlocks(b *strings.Builder, lines []string, tight bool) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if strings.TrimSpace(line) == "" {
+			i++
+			continue
+		}
+
+		if m := fencePattern.FindStringSubmatch(line); m != nil {
+			indent, fence := len(m[1]), m[2]
+			i++
+			var code []string
+			for ; i < len(lines); i++ {
+				t := strings.TrimSpace(lines[i])
+				if strings.HasPrefix(t, fence) && strings.Trim(t, fence[:1]) == "" {
+					i++
+					break
+				}
+				code = append(code, trimIndent(lines[i], indent))
+			}
+			b.WriteString("<pre><code")
+			if lang := strings.Fields(m[3]); len(lang) > 0 {
+				b.WriteString(` class="language-` + html.EscapeString(lang[0]) + `"`)
+			}
+			b.WriteString(">" + html.EscapeString(strings.Join(code, "\n")))
+			if len(code) > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString("</code></pre>\n")
+			continue
+		}
+
+		if indentation(line) >= 4 {
+			var code []string
+			for ; i < len(lines) && (indentation(lines[i]) >= 4 || strings.TrimSpace(lines[i]) == ""); i++ {
+				code = append(code, trimIndent(lines[i], 4))
+			}
+			for len(code) > 0 && strings.TrimSpace(code[len(code)-1]) == "" {
+				code = code[:len(code)-1]
+			}
+			b.WriteString("<pre><code>" + html.EscapeString(strings.Join(code, "\n")) + "\n</code></pre>\n")
+			continue
+		}
+
+		if m := atxHeadingPattern.FindStringSubmatch(line); m != nil {
+			level := strconv.Itoa(len(m[1]))
+			b.WriteString("<h" + level + ">" + markdownInline(m[2]) + "</h" + level + ">\n")
+			i++
+			continue
+		}
+
+		if thematicBreakPattern.MatchString(line) {
+			b.WriteString("<hr>\n")
+			i++
+			continue
+		}
+
+		if isBlockquote(line) {
+			var quoted []string
+			for ; i < len(lines); i++ {
+				l := lines[i]
+				if isBlockquote(l) {
+					l = strings.TrimLeft(l, " ")[1:]
+					quoted = append(quoted, strings.TrimPrefix(l, " "))
+					continue
+				}
+				// Lazy continuation lines of a quoted paragraph.
+				if strings.TrimSpace(l) == "" || startsBlock(l) || len(quoted) == 0 || strings.TrimSpace(quoted[len(quoted)-1]) == "" {
+					break
+				}
+				quoted = append(quoted, l)
+			}
+			b.WriteString("<blockquote>\n")
+			markdownBlocks(b, quoted, false)
+			b.WriteString("</blockquote>\n")
+			continue
+		}
+
+		if listItemPattern.MatchString(line) {
+			i = markdownList(b, lines, i)
+			continue
+		}
+
+		if htmlBlockPattern.MatchString(line) {
+			for ; i < len(lines) && strings.TrimSpace(lines[i]) != ""; i++ {
+				b.WriteString(lines[i] + "\n")
+			}
+			continue
+		}
+
+		if i+1 < len(lines) && strings.Contains(line, "|") && tableDelimiterRow.MatchString(lines[i+1]) && strings.Contains(lines[i+1], "-") {
+			i = markdownTable(b, lines, i)
+			continue
+		}
+
+		// Paragraph, possibly with a setext heading underline.
+		var paragraph []string
+		heading := ""
+		for ; i < len(lines); i++ {
+			l := lines[i]
+			if strings.TrimSpace(l) == "" {
+				break
+			}
+			if len(paragraph) > 0 {
+				if m := setextPattern.FindStringSubmatch(l); m != nil {
+					heading = "h1"
+					if m[1][0] == '-' {
+						heading = "h2"
+					}
+					i++
+					break
+				}
+				if startsBlock(l) {
+					break
+				}
+			}
+			paragraph = append(paragraph, strings.TrimLeft(l, " "))
+		}
+		content := markdownInline(strings.Join(paragraph, "\n"))
+		switch {
+		case heading != "":
+			b.WriteString("<" + heading + ">" + content + "</" + heading + ">\n")
+		case tight:
+			b.WriteString(content + "\n")
+		default:
+			b.WriteString("<p>" + content + "</p>\n")
+		}
+	}
+}
+
+// startsBlock reports whether the line interrupts a paragraph.
+func startsBlock(line string) bool {
+	if atxHeadingPattern.MatchString(line) || thematicBreakPattern.MatchString(line) || fencePattern.MatchString(line) || isBlockquote(line) || htmlBlockPattern.MatchString(line) {
+		return true
+	}
+	// Only bullet and ordered lists that start with 1 interrupt paragraphs.
+	if m := listItemPattern.FindStringSubmatch(line); m != nil && strings.TrimSpace(m[4]) != "" {
+		return !isOrderedMarker(m[2]) || m[2][:len(m[2])-1] == "1"
+	}
+	return false
+}
+
+func isBlockquote(line string) bool {
+	return indentation(line) < 4 && strings.HasPrefix(strings.TrimLeft(line, " "), ">")
+}
+
+func isOrderedMarker(marker string) bool {
+	return marker[0] >= '0' && marker[0] <= '9'
+}
+
+// markdownList writes a list that starts at the line and returns the index
+// of the first line after it.
+func markdownList(b *strings.Builder, lines []string, i int) int {
+	first := listItemPattern.FindStringSubmatch(lines[i])
+	ordered := isOrderedMarker(first[2])
+	delimiter := first[2][len(first[2])-1:]
+
+	type item struct {
+		lines []string
+	}
+	var items []item
+	tight := true
+	contentIndent := 0
+	blank := false
+	for i < len(lines) {
+		line := lines[i]
+		if m := listItemPattern.FindStringSubmatch(line); m != nil && (len(items) == 0 || indentation(line) < contentIndent) {
+			if isOrderedMarker(m[2]) != ordered || m[2][len(m[2])-1:] != delimiter {
+				break
+			}
+			if blank && len(items) > 0 {
+				tight = false
+			}
+			spaces := len(m[3])
+			if spaces > 4 || m[4] == "" {
+				spaces = 1
+			}
+			contentIndent = len(m[1]) + len(m[2]) + spaces
+			items = append(items, item{lines: []string{m[4]}})
+			blank = false
+			i++
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			blank = true
+			items[len(items)-1].lines = append(items[len(items)-1].lines, "")
+			i++
+			continue
+		}
+		if indentation(line) >= contentIndent {
+			if blank && !isNestedList(items[len(items)-1].lines) {
+				tight = false
+			}
+			items[len(items)-1].lines = append(items[len(items)-1].lines, trimIndent(line, contentIndent))
+			blank = false
+			i++
+			continue
+		}
+		// Lazy continuation lines of a paragraph in the item.
+		if !blank && !startsBlock(line) {
+			items[len(items)-1].lines = append(items[len(items)-1].lines, strings.TrimLeft(line, " "))
+			i++
+			continue
+		}
+		break
+	}
+
+	if ordered {
+		start, _ := strconv.Atoi(first[2][:len(first[2])-1])
+		if start != 1 {
+			b.WriteString(`<ol start="` + strconv.Itoa(start) + `">` + "\n")
+		} else {
+			b.WriteString("<ol>\n")
+		}
+	} else {
+		b.WriteString("<ul>\n")
+	}
+	for _, it := range items {
+		b.WriteString("<li>")
+		markdownBlocks(b, it.lines, tight)
+		b.WriteString("</li>\n")
+	}
+	if ordered {
+		b.WriteString("</ol>\n")
+	} else {
+		b.WriteString("</ul>\n")
+	}
+	return i
+}
+
+// isNestedList reports whether the last non-blank item line is a list item,
+// as blank lines between nested list items do not make the outer list loose.
+func isNestedList(lines []string) bool {
+	for i := len(lines) - 1; i >= 0; i-- {
+		if strings.TrimSpace(lines[i]) != "" {
+			return listItemPattern.MatchString(lines[i]) || indentation(lines[i]) > 0
+		}
+	}
+	return false
+}
+
+// markdownTable writes a GitHub table that starts at the line and returns the
+// index of the first line after it.
+func markdownTable(b *strings.Builder, lines []string, i int) int {
+	var aligns []string
+	for _, cell := range tableCells(lines[i+1]) {
+		cell = strings.TrimSpace(cell)
+		switch {
+		case strings.HasPrefix(cell, ":") && strings.HasSuffix(cell, ":"):
+			aligns = append(aligns, "center")
+		case strings.HasSuffix(cell, ":"):
+			aligns = append(aligns, "right")
+		case strings.HasPrefix(cell, ":"):
+			aligns = append(aligns, "left")
+		default:
+			aligns = append(aligns, "")
+		}
+	}
+	row := func(line, tag string) {
+		b.WriteString("<tr>")
+		cells := tableCells(line)
+		for j := range aligns {
+			cell := ""
+			if j < len(cells) {
+				cell = cells[j]
+			}
+			b.WriteString("<" + tag)
+			if aligns[j] != "" {
+				b.WriteString(` align="` + aligns[j] + `"`)
+			}
+			b.WriteString(">" + markdownInline(strings.TrimSpace(cell)) + "</" + tag + ">")
+		}
+		b.WriteString("</tr>\n")
+	}
+
+	b.WriteString("<table>\n<thead>\n")
+	row(lines[i], "th")
+	b.WriteString("</thead>\n<tbody>\n")
+	for i += 2; i < len(lines) && strings.TrimSpace(lines[i]) != "" && strings.Contains(lines[i], "|") && !startsBlock(lines[i]); i++ {
+		row(lines[i], "td")
+	}
+	b.WriteString("</tbody>\n</table>\n")
+	return i
+}
+
+// tableCells splits a table row into cells on pipes that are not escaped.
+func tableCells(line string) (cells []string) {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+	start := 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '|':
+			cells = append(cells, strings.ReplaceAll(line[start:i], `\|`, "|"))
+			start = i + 1
+		}
+	}
+	return append(cells, strings.ReplaceAll(line[start:], `\|`, "|"))
+}
+
+func indentation(line string) (n int) {
+	for n < len(line) && line[n] == ' ' {
+		n++
+	}
+	return n
+}
+
+// trimIndent removes up to n leading spaces.
+func trimIndent(line string, n int) string {
+	i := 0
+	for i < n && i < len(line) && line[i] == ' ' {
+		i++
+	}
+	return line[i:]
+}
+
+var inlineTagPattern = regexp.MustCompile(`^(?:<[a-zA-Z][a-zA-Z0-9-]*(?:\s+[a-zA-Z_:][-a-zA-Z0-9_.:]*(?:\s*=\s*(?:[^\s"'=<>` + "`" + `]+|'[^']*'|"[^"]*"))?)*\s*/?>|</[a-zA-Z][a-zA-Z0-9-]*\s*>|<!--[\s\S]*?-->)`)
+
+// markdownInline converts inline Markdown to HTML.
+func markdownInline(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && s[i+1] == '\n':
+			b.WriteString("<br>\n")
+			i += 2
+			continue
+		case c == '\\' && i+1 < len(s) && isASCIIPunct(s[i+1]):
+			b.WriteString(html.EscapeString(s[i+1 : i+2]))
+			i += 2
+			continue
+		case c == '`':
+			if html, n, ok := codeSpan(s[i:]); ok {
+				b.WriteString(html)
+				i += n
+				continue
+			}
+			n := 0
+			for i+n < len(s) && s[i+n] == '`' {
+				n++
+			}
+			b.WriteString(s[i : i+n])
+			i += n
+			continue
+		case c == '!' && strings.HasPrefix(s[i+1:], "["):
+			if text, dest, title, n, ok := link(s[i+1:]); ok {
+				b.WriteString(`<img src="` + html.EscapeString(dest) + `" alt="` + html.EscapeString(plainText(text)) + `"`)
+				if title != "" {
+					b.WriteString(` title="` + html.EscapeString(title) + `"`)
+				}
+				b.WriteString(">")
+				i += 1 + n
+				continue
+			}
+		case c == '[':
+			if text, dest, title, n, ok := link(s[i:]); ok {
+				b.WriteString(`<a href="` + html.EscapeString(dest) + `"`)
+				if title != "" {
+					b.WriteString(` title="` + html.EscapeString(title) + `"`)
+				}
+				b.WriteString(">" + markdownInline(text) + "</a>")
+				i += n
+				continue
+			}
+		case c == '<':
+			if end := strings.IndexByte(s[i:], '>'); end > 0 {
+				inner := s[i+1 : i+end]
+				if isAutolink(inner) {
+					href := inner
+					if !strings.Contains(inner, ":") {
+						href = "mailto:" + inner
+					}
+					b.WriteString(`<a href="` + html.EscapeString(href) + `">` + html.EscapeString(inner) + "</a>")
+					i += end + 1
+					continue
+				}
+			}
+			if m := inlineTagPattern.FindString(s[i:]); m != "" {
+				b.WriteString(m)
+				i += len(m)
+				continue
+			}
+		case c == '*' || c == '_' || c == '~':
+			if html, n, ok := emphasis(s, i); ok {
+				b.WriteString(html)
+				i += n
+				continue
+			}
+			n := 0
+			for i+n < len(s) && s[i+n] == c {
+				n++
+			}
+			b.WriteString(s[i : i+n])
+			i += n
+			continue
+		case c == '&':
+			if m := entityPattern.FindString(s[i:]); m != "" {
+				b.WriteString(m)
+				i += len(m)
+				continue
+			}
+		case c == '\n':
+			if i >= 2 && s[i-2:i] == "  " {
+				b.WriteString("<br>\n")
+			} else {
+				b.WriteString("\n")
+			}
+			i++
+			continue
+		case c == 'h' || c == 'w':
+			if url, n := bareURL(s, i); n > 0 {
+				b.WriteString(`<a href="` + html.EscapeString(url) + `">` + html.EscapeString(s[i:i+n]) + "</a>")
+				i += n
+				continue
+			}
+		}
+		b.WriteString(html.EscapeString(s[i : i+1]))
+		i++
+	}
+	return b.String()
+}
+
+var (
+	entityPattern    = regexp.MustCompile(`^&(?:#[0-9]{1,7}|#[xX][0-9a-fA-F]{1,6}|[a-zA-Z][a-zA-Z0-9]{1,31});`)
+	autolinkPattern  = regexp.MustCompile(`^(?:[a-zA-Z][a-zA-Z0-9+.-]{1,31}:[^\s<>]*|[a-zA-Z0-9.!#$%&'*+/=?^_` + "`" + `{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*)$`)
+	bareURLPattern   = regexp.MustCompile(`^(?:https?://|www\.)[^\s<]+`)
+	trailingURLPunct = ".,:;!?\"'*_~"
+	blankLinePattern = regexp.MustCompile(`\n[ \t]*\n`)
+)
+
+func isAutolink(s string) bool {
+	return autolinkPattern.MatchString(s)
+}
+
+// bareURL returns the address of a URL that starts at the index, which is
+// not a part of a word, and its length in the string.
+func bareURL(s string, i int) (url string, n int) {
+	if i > 0 && (isASCIILetter(s[i-1]) || s[i-1] >= '0' && s[i-1] <= '9' || s[i-1] == '/' || s[i-1] == '"' || s[i-1] == '=') {
+		return "", 0
+	}
+	m := bareURLPattern.FindString(s[i:])
+	if m == "" {
+		return "", 0
+	}
+	for len(m) > 0 {
+		last := m[len(m)-1]
+		if strings.IndexByte(trailingURLPunct, last) >= 0 || last == ')' && strings.Count(m, "(") < strings.Count(m, ")") {
+			m = m[:len(m)-1]
+			continue
+		}
+		break
+	}
+	if m == "" || m == "www." {
+		return "", 0
+	}
+	url = m
+	if strings.HasPrefix(url, "www.") {
+		url = "http://" + url
+	}
+	return url, len(m)
+}
+
+// codeSpan returns HTML for a code span that starts the string and its
+// length.
+func codeSpan(s string) (h string, n int, ok bool) {
+	ticks := 0
+	for ticks < len(s) && s[ticks] == '`' {
+		ticks++
+	}
+	for i := ticks; i < len(s); {
+		j := strings.IndexByte(s[i:], '`')
+		if j < 0 {
+			return "", 0, false
+		}
+		j += i
+		k := j
+		for k < len(s) && s[k] == '`' {
+			k++
+		}
+		if k-j == ticks {
+			code := strings.ReplaceAll(s[ticks:j], "\n", " ")
+			if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+				code = code[1 : len(code)-1]
+			}
+			return "<code>" + html.EscapeString(code) + "</code>", k, true
+		}
+		i = k
+	}
+	return "", 0, false
+}
+
+// link parses an inline link that starts the string with [, and returns its
+// text, destination, title and length.
+func link(s string) (text, dest, title string, n int, ok bool) {
+	depth := 0
+	end := -1
+	for i := 0; i < len(s) && end < 0; i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '`':
+			if _, n, ok := codeSpan(s[i:]); ok {
+				i += n - 1
+			}
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				end = i
+			}
+		}
+	}
+	if end < 0 || end+1 >= len(s) || s[end+1] != '(' {
+		return "", "", "", 0, false
+	}
+	text = s[1:end]
+
+	i := end + 2
+	for i < len(s) && isSpace(s[i]) {
+		i++
+	}
+	if i < len(s) && s[i] == '<' {
+		j := strings.IndexAny(s[i+1:], ">\n")
+		if j < 0 || s[i+1+j] != '>' {
+			return "", "", "", 0, false
+		}
+		dest = s[i+1 : i+1+j]
+		i += j + 2
+	} else {
+		start := i
+		parens := 0
+		for ; i < len(s) && !isSpace(s[i]); i++ {
+			if s[i] == '\\' && i+1 < len(s) {
+				i++
+				continue
+			}
+			if s[i] == '(' {
+				parens++
+			}
+			if s[i] == ')' {
+				if parens == 0 {
+					break
+				}
+				parens--
+			}
+		}
+		dest = s[start:i]
+	}
+	for i < len(s) && isSpace(s[i]) {
+		i++
+	}
+	if i < len(s) && (s[i] == '"' || s[i] == '\'' || s[i] == '(') {
+		closing := s[i]
+		if closing == '(' {
+			closing = ')'
+		}
+		j := strings.IndexByte(s[i+1:], closing)
+		if j < 0 {
+			return "", "", "", 0, false
+		}
+		title = html.UnescapeString(s[i+1 : i+1+j])
+		i += j + 2
+		for i < len(s) && isSpace(s[i]) {
+			i++
+		}
+	}
+	if i >= len(s) || s[i] != ')' {
+		return "", "", "", 0, false
+	}
+	return text, unescapeMarkdown(html.UnescapeString(dest)), title, i + 1, true
+}
+
+// emphasis returns HTML for emphasis, strong emphasis or strikethrough that
+// starts at the index and its length.
+func emphasis(s string, i int) (h string, n int, ok bool) {
+	c := s[i]
+	run := 0
+	for i+run < len(s) && s[i+run] == c {
+		run++
+	}
+	if c == '~' && run != 2 {
+		return "", 0, false
+	}
+	if run > 2 {
+		run = 2
+	}
+	delimiter := s[i : i+run]
+	// Opening delimiters must be followed by a non-space character, and
+	// underscores must not be inside words.
+	if i+run >= len(s) || isSpace(s[i+run]) {
+		return "", 0, false
+	}
+	if c == '_' && i > 0 && isWordChar(s[i-1]) {
+		return "", 0, false
+	}
+
+	for j := i + run; j < len(s); j++ {
+		switch {
+		case s[j] == '`':
+			if _, n, ok := codeSpan(s[j:]); ok {
+				j += n - 1
+			}
+			continue
+		case s[j] == '\\':
+			j++
+			continue
+		case !strings.HasPrefix(s[j:], delimiter):
+			continue
+		}
+		// Closing delimiters must be preceded by a non-space character and
+		// not be a part of a longer run.
+		end := j + run
+		if isSpace(s[j-1]) || end < len(s) && s[end] == c || j > i+run && s[j-1] == c {
+			continue
+		}
+		if c == '_' && end < len(s) && isWordChar(s[end]) {
+			continue
+		}
+		inner := s[i+run : j]
+		tag := "em"
+		switch {
+		case c == '~':
+			tag = "del"
+		case run == 2:
+			tag = "strong"
+		}
+		return "<" + tag + ">" + markdownInline(inner) + "</" + tag + ">", end - i, true
+	}
+	return "", 0, false
+}
+
+func isWordChar(c byte) bool {
+	return isASCIILetter(c) || c >= '0' && c <= '9' || c >= 0x80
+}
+
+func isASCIIPunct(c byte) bool {
+	return c >= '!' && c <= '/' || c >= ':' && c <= '@' || c >= '[' && c <= '`' || c >= '{' && c <= '~'
+}
+
+func unescapeMarkdown(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && isASCIIPunct(s[i+1]) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// plainText returns the text of inline Markdown without formatting, used for
+// image descriptions.
+func plainText(s string) string {
+	return parseHTML(markdownInline(s)).textContent()
+}
+
+// textToHTML converts plain text to HTML paragraphs with line breaks and
+// links for URLs.
+func textToHTML(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	var b strings.Builder
+	for _, paragraph := range blankLinePattern.Split(strings.TrimSpace(s), -1) {
+		if strings.TrimSpace(paragraph) == "" {
+			continue
+		}
+		b.WriteString("<p>")
+		for i, line := range strings.Split(paragraph, "\n") {
+			if i > 0 {
+				b.WriteString("<br>\n")
+			}
+			for j := 0; j < len(line); {
+				if line[j] == 'h' || line[j] == 'w' {
+					if url, n := bareURL(line, j); n > 0 {
+						b.WriteString(`<a href="` + html.EscapeString(url) + `">` + html.EscapeString(line[j:j+n]) + "</a>")
+						j += n
+						continue
+					}
+				}
+				b.WriteString(html.EscapeString(line[j : j+1]))
+				j++
+			}
+		}
+		b.WriteString("</p>\n")
+	}
+	return b.String()
+}
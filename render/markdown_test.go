@@ -0,0 +1,112 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render_test
+
+import (
+	"testing"
+
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/render"
+)
+
+func TestParseFormat_markdown(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "headings",
+			in:   "# One\n## Two ##\nThree\n=====\nFour\n----",
+			want: "<h1>One</h1>\n<h2>Two</h2>\n<h1>Three</h1>\n<h2>Four</h2>",
+		},
+		{
+			name: "paragraphs and breaks",
+			in:   "first line\nsecond line  \nthird line\\\nfourth\n\nnext",
+			want: "<p>first line\nsecond line  <br>\nthird line<br>\nfourth</p>\n<p>next</p>",
+		},
+		{
+			name: "emphasis",
+			in:   "**strong** __strong__ *em* _em_ ~~del~~ snake_case_name 2*3*4 ** not **",
+			want: "<p><strong>strong</strong> <strong>strong</strong> <em>em</em> <em>em</em> <del>del</del> snake_case_name 2<em>3</em>4 ** not **</p>",
+		},
+		{
+			name: "code spans",
+			in:   "use `a < b` and ``code with ` tick`` and `unclosed",
+			want: "<p>use <code>a &lt; b</code> and <code>code with ` tick</code> and `unclosed</p>",
+		},
+		{
+			name: "links",
+			in:   `[text](https://example.com "Title") [rel](../docs) [parens](https://en.wikipedia.org/wiki/Go_(language)) [angle](<a b>) [not a link] ![img](/i.png)`,
+			want: `<p><a href="https://example.com" title="Title">text</a> <a href="https://example.com/docs">rel</a> <a href="https://en.wikipedia.org/wiki/Go_(language)">parens</a> <a href="https://example.com/releases/a%20b">angle</a> [not a link] <img src="https://example.com/i.png" alt="img"></p>`,
+		},
+		{
+			name: "autolinks",
+			in:   "<https://example.com> <security@example.com> see https://example.com/a_b. and (https://example.com/x) www.example.com",
+			want: `<p><a href="https://example.com">https://example.com</a> <a href="mailto:security@example.com">security@example.com</a> see <a href="https://example.com/a_b">https://example.com/a_b</a>. and (<a href="https://example.com/x">https://example.com/x</a>) <a href="http://www.example.com">www.example.com</a></p>`,
+		},
+		{
+			name: "escapes",
+			in:   `\*not em\* \[not link\] 1 \< 2 &amp; &copy; & done`,
+			want: `<p>*not em* [not link] 1 &lt; 2 &amp; © &amp; done</p>`,
+		},
+		{
+			name: "lists",
+			in:   "- one\n- two\n  continued\n  - nested\n- three\n\n3. three\n4. four",
+			want: "<ul>\n<li>one\n</li>\n<li>two\ncontinued\n<ul>\n<li>nested\n</li>\n</ul>\n</li>\n<li>three\n</li>\n</ul>\n<ol start=\"3\">\n<li>three\n</li>\n<li>four\n</li>\n</ol>",
+		},
+		{
+			name: "loose lists",
+			in:   "* one\n\n* two",
+			want: "<ul>\n<li><p>one</p>\n</li>\n<li><p>two</p>\n</li>\n</ul>",
+		},
+		{
+			name: "list types",
+			in:   "- one\n+ two",
+			want: "<ul>\n<li>one\n</li>\n</ul>\n<ul>\n<li>two\n</li>\n</ul>",
+		},
+		{
+			name: "fenced code",
+			in:   "```go\nif a < b {\n}\n```\n~~~\n```\n~~~",
+			want: "<pre><code class=\"language-go\">if a &lt; b {\n}\n</code></pre>\n<pre><code>```\n</code></pre>",
+		},
+		{
+			name: "indented code",
+			in:   "    make build\n\n    make test",
+			want: "<pre><code>make build\n\nmake test\n</code></pre>",
+		},
+		{
+			name: "blockquotes",
+			in:   "> quoted\nlazy\n> > nested",
+			want: "<blockquote>\n<p>quoted\nlazy</p>\n<blockquote>\n<p>nested</p>\n</blockquote>\n</blockquote>",
+		},
+		{
+			name: "thematic breaks",
+			in:   "one\n\n***\n- - -\ntwo",
+			want: "<p>one</p>\n<hr>\n<hr>\n<p>two</p>",
+		},
+		{
+			name: "tables",
+			in:   "| Name | Value |\n| :--- | ----: |\n| `a\\|b` | **1** |\n| c |",
+			want: "<table>\n<thead>\n<tr><th align=\"left\">Name</th><th align=\"right\">Value</th></tr>\n</thead>\n<tbody>\n<tr><td align=\"left\"><code>a|b</code></td><td align=\"right\"><strong>1</strong></td></tr>\n<tr><td align=\"left\">c</td><td align=\"right\"></td></tr>\n</tbody>\n</table>",
+		},
+		{
+			name: "html",
+			in:   "<details>\n<summary>More</summary>\n\nHidden *text* with <kbd>Ctrl</kbd> and <script>alert(1)</script>\n\n</details>",
+			want: "<details>\n<summary>More</summary>\n<p>Hidden <em>text</em> with <kbd>Ctrl</kbd> and </p>\n</details>",
+		},
+		{
+			name: "unsafe links",
+			in:   "[click](javascript:alert(1)) <javascript:alert(1)>",
+			want: "<p>click javascript:alert(1)</p>",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := render.ParseFormat(tc.in, render.FormatMarkdown, "https://example.com/releases/v1.1.0").HTML()
+			testutil.AssertEqual(t, "", got, tc.want)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package render converts release note messages, which are plain text, HTML
+// or Markdown depending on the provider, to plain text, wrapped terminal
+// text, sanitized HTML or Markdown.
+//
+// Messages are parsed into an HTML tree, with Markdown and plain text
+// converted to HTML first. Only known elements and attributes are written to
+// the output, so scripts, styles, event handler attributes and links with
+// unsafe schemes are removed, and relative links are resolved against the
+// release note URL.
+package render // import "newreleases.io/newreleases/render"
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"newreleases.io/newreleases"
+)
+
+// DateFormat is the layout of dates in Markdown and HTML reports.
+const DateFormat = "2006-01-02"
+
+// Format is a release note message format.
+type Format int
+
+// Supported message formats.
+const (
+	FormatText Format = iota
+	FormatHTML
+	FormatMarkdown
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatHTML:
+		return "html"
+	case FormatMarkdown:
+		return "markdown"
+	}
+	return "text"
+}
+
+var (
+	htmlTagPattern  = regexp.MustCompile(`(?i)</?(?:a|b|blockquote|br|code|details|div|em|h[1-6]|hr|i|img|li|ol|p|pre|span|strong|summary|table|td|th|tr|ul)(?:\s[^<>]*)?/?>`)
+	markdownPattern = regexp.MustCompile("(?m)^(?:#{1,6}\\s|\\s{0,3}[-*+]\\s+\\S|\\s{0,3}\\d{1,9}[.)]\\s+\\S|\\s{0,3}>|\\s{0,3}```|\\s{0,3}~~~)|\\[[^\\]\\n]+\\]\\([^)\\s]+\\)|\\*\\*[^*\\s][^*\\n]*\\*\\*|`[^`\\n]+`")
+)
+
+// Detect returns the format of a message. Messages that start with an HTML
+// tag are HTML, and Markdown messages may contain HTML tags.
+func Detect(message string) Format {
+	s := strings.TrimSpace(message)
+	hasHTML := htmlTagPattern.MatchString(s)
+	switch {
+	case hasHTML && strings.HasPrefix(s, "<"):
+		return FormatHTML
+	case markdownPattern.MatchString(s):
+		return FormatMarkdown
+	case hasHTML:
+		return FormatHTML
+	}
+	return FormatText
+}
+
+// Document is a parsed release note message.
+type Document struct {
+	root *node
+	base *url.URL
+}
+
+// Parse parses the release note message, detecting its format, with the
+// release note URL as the base for relative links.
+func Parse(note *newreleases.ReleaseNote) (d *Document) {
+	return ParseFormat(note.Message, Detect(note.Message), note.URL)
+}
+
+// ParseFormat parses a message in the format. Relative links are resolved
+// against the base URL, if it is a valid absolute URL.
+func ParseFormat(message string, f Format, baseURL string) (d *Document) {
+	var h string
+	switch f {
+	case FormatHTML:
+		h = message
+	case FormatMarkdown:
+		h = markdownToHTML(message)
+	default:
+		h = textToHTML(message)
+	}
+	d = &Document{root: parseHTML(h)}
+	if u, err := url.Parse(baseURL); err == nil && u.IsAbs() {
+		d.base = u
+	}
+	return d
+}
+
+// HTML returns the sanitized HTML of the message.
+func (d *Document) HTML() string {
+	var b strings.Builder
+	writeHTML(&b, d.root, d.base)
+	return strings.TrimSpace(b.String())
+}
+
+// Markdown returns the message as Markdown.
+func (d *Document) Markdown() string {
+	w := &writer{markdown: true, base: d.base}
+	return w.blocks(d.root, 0)
+}
+
+// Text returns the message as plain text, with links written after their
+// texts.
+func (d *Document) Text() string {
+	w := &writer{base: d.base}
+	return w.blocks(d.root, 0)
+}
+
+// Terminal returns the message as plain text with lines wrapped at the width,
+// where possible. Preformatted text and words longer than the width are not
+// wrapped.
+func (d *Document) Terminal(width int) string {
+	w := &writer{base: d.base}
+	return w.blocks(d.root, width)
+}
+
+// resolveURL returns the URL resolved against the base URL and reports
+// whether it is safe to link to. Only http, https and mailto schemes are
+// allowed.
+func resolveURL(raw string, base *url.URL) (s string, ok bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", false
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", false
+	}
+	if u.Scheme == "" && base != nil {
+		u = base.ResolveReference(u)
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "", "http", "https", "mailto":
+		return u.String(), true
+	}
+	return "", false
+}
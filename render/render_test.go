@@ -0,0 +1,94 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render_test
+
+import (
+	"testing"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/render"
+)
+
+func TestDetect(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		message string
+		want    render.Format
+	}{
+		{name: "text", message: "Bug fixes and improvements.\nSee https://example.com.", want: render.FormatText},
+		{name: "html", message: "<p>Bug fixes</p>\n<ul><li>one</li></ul>", want: render.FormatHTML},
+		{name: "html inside text", message: "Fixed the <b>bold</b> rendering.", want: render.FormatHTML},
+		{name: "markdown heading", message: "## Changes\n\nFixed things.", want: render.FormatMarkdown},
+		{name: "markdown list", message: "Changes:\n* one\n* two", want: render.FormatMarkdown},
+		{name: "markdown link", message: "See [docs](https://example.com).", want: render.FormatMarkdown},
+		{name: "markdown with html", message: "## Changes\n<details><summary>More</summary>text</details>", want: render.FormatMarkdown},
+		{name: "comparison", message: "Requires a < b and c > d.", want: render.FormatText},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testutil.AssertEqual(t, "", render.Detect(tc.message), tc.want)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	d := render.Parse(&newreleases.ReleaseNote{
+		Title:   "v1.1.0",
+		Message: "## What's Changed\n* Fix `foo_bar` by @alice in [#12](/acme/app/pull/12)\n* Add **bold** support\n\n**Full Changelog**: https://github.com/acme/app/compare/v1.0.0...v1.1.0",
+		URL:     "https://github.com/acme/app/releases/tag/v1.1.0",
+	})
+
+	testutil.AssertEqual(t, "html", d.HTML(), `<h2>What's Changed</h2>
+<ul>
+<li>Fix <code>foo_bar</code> by @alice in <a href="https://github.com/acme/app/pull/12">#12</a>
+</li>
+<li>Add <strong>bold</strong> support
+</li>
+</ul>
+<p><strong>Full Changelog</strong>: <a href="https://github.com/acme/app/compare/v1.0.0...v1.1.0">https://github.com/acme/app/compare/v1.0.0...v1.1.0</a></p>`)
+
+	testutil.AssertEqual(t, "markdown", d.Markdown(), "## What's Changed\n"+
+		"\n"+
+		"- Fix `foo_bar` by @alice in [#12](https://github.com/acme/app/pull/12)\n"+
+		"- Add **bold** support\n"+
+		"\n"+
+		"**Full Changelog**: <https://github.com/acme/app/compare/v1.0.0...v1.1.0>")
+
+	testutil.AssertEqual(t, "text", d.Text(), `What's Changed
+--------------
+
+- Fix foo_bar by @alice in #12 (https://github.com/acme/app/pull/12)
+- Add bold support
+
+Full Changelog: https://github.com/acme/app/compare/v1.0.0...v1.1.0`)
+
+	testutil.AssertEqual(t, "terminal", d.Terminal(30), `What's Changed
+--------------
+
+- Fix foo_bar by @alice in #12
+  (https://github.com/acme/app/pull/12)
+- Add bold support
+
+Full Changelog:
+https://github.com/acme/app/compare/v1.0.0...v1.1.0`)
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Run("forced format", func(t *testing.T) {
+		d := render.ParseFormat("<b>not bold</b> *not emphasis*", render.FormatText, "")
+		testutil.AssertEqual(t, "", d.HTML(), "<p>&lt;b&gt;not bold&lt;/b&gt; *not emphasis*</p>")
+	})
+
+	t.Run("relative links without base", func(t *testing.T) {
+		d := render.ParseFormat("[docs](/docs)", render.FormatMarkdown, "not a url")
+		testutil.AssertEqual(t, "", d.HTML(), `<p><a href="/docs">docs</a></p>`)
+	})
+
+	t.Run("anchor links", func(t *testing.T) {
+		d := render.ParseFormat(`<a href="#install">install</a>`, render.FormatHTML, "https://example.com/releases/v1")
+		testutil.AssertEqual(t, "", d.Markdown(), "[install](https://example.com/releases/v1#install)")
+	})
+}
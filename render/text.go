@@ -0,0 +1,527 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// blockElements are written as separate blocks. Other elements that are
+	// not inline are replaced by their content.
+	blockElements  = toSet("address", "article", "aside", "blockquote", "dd", "details", "div", "dl", "dt", "fieldset", "figcaption", "figure", "footer", "form", "h1", "h2", "h3", "h4", "h5", "h6", "header", "hr", "li", "main", "nav", "ol", "p", "pre", "section", "summary", "table", "ul")
+	inlineElements = toSet("a", "abbr", "b", "bdi", "bdo", "br", "cite", "code", "data", "del", "dfn", "em", "i", "img", "ins", "kbd", "mark", "q", "s", "samp", "small", "span", "strike", "strong", "sub", "sup", "time", "tt", "u", "var", "wbr")
+
+	spacesPattern         = regexp.MustCompile(`[ \t\n\r\f]+`)
+	orderedLineStart      = regexp.MustCompile(`^(\d{1,9})([.)])`)
+	markdownLineStartChar = "#>-+="
+)
+
+// writer writes a parsed HTML tree as Markdown or as plain text.
+type writer struct {
+	markdown bool
+	base     *url.URL
+}
+
+// blocks returns the node children as blocks separated by blank lines, with
+// text lines wrapped at the width if it is greater than zero.
+func (w *writer) blocks(n *node, width int) string {
+	return w.joinBlocks(n, width, "\n\n")
+}
+
+// joinBlocks returns the node children as blocks joined with the separator.
+func (w *writer) joinBlocks(n *node, width int, separator string) string {
+	var blocks []string
+	var inline strings.Builder
+	flush := func() {
+		if p := w.paragraph(inline.String(), width); p != "" {
+			blocks = append(blocks, p)
+		}
+		inline.Reset()
+	}
+	var walk func(n *node)
+	walk = func(n *node) {
+		for _, c := range n.children {
+			switch {
+			case c.tag == "" || inlineElements[c.tag]:
+				w.writeInline(&inline, c)
+			case droppedElements[c.tag]:
+			case blockElements[c.tag]:
+				flush()
+				if b := w.block(c, width); b != "" {
+					blocks = append(blocks, b)
+				}
+			default:
+				walk(c)
+			}
+		}
+	}
+	walk(n)
+	flush()
+	return strings.Join(blocks, separator)
+}
+
+func (w *writer) block(n *node, width int) string {
+	switch n.tag {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		text := strings.ReplaceAll(w.paragraph(w.inline(n), 0), "\n", " ")
+		if text == "" {
+			return ""
+		}
+		level := int(n.tag[1] - '0')
+		if w.markdown {
+			return strings.Repeat("#", level) + " " + text
+		}
+		if width > 0 {
+			text = strings.Join(wrap(text, width), "\n")
+		}
+		switch level {
+		case 1:
+			return text + "\n" + strings.Repeat("=", underlineWidth(text))
+		case 2:
+			return text + "\n" + strings.Repeat("-", underlineWidth(text))
+		}
+		return text
+	case "hr":
+		if !w.markdown && width > 0 {
+			return strings.Repeat("-", width)
+		}
+		return "---"
+	case "pre":
+		code := strings.TrimRight(n.textContent(), "\n")
+		if strings.TrimSpace(code) == "" {
+			return ""
+		}
+		if w.markdown {
+			fence := "```"
+			for strings.Contains(code, fence) {
+				fence += "`"
+			}
+			return fence + codeLanguage(n) + "\n" + code + "\n" + fence
+		}
+		return indentLines(code, "    ", "    ")
+	case "blockquote":
+		inner := w.blocks(n, innerWidth(width, 2))
+		if inner == "" {
+			return ""
+		}
+		lines := strings.Split(inner, "\n")
+		for i, l := range lines {
+			if l == "" {
+				lines[i] = ">"
+			} else {
+				lines[i] = "> " + l
+			}
+		}
+		return strings.Join(lines, "\n")
+	case "ul", "ol":
+		return w.list(n, width)
+	case "table":
+		return w.table(n)
+	}
+	return w.blocks(n, width)
+}
+
+func (w *writer) list(n *node, width int) string {
+	number := 1
+	if s, err := strconv.Atoi(n.attr("start")); err == nil && n.tag == "ol" {
+		number = s
+	}
+	var items []string
+	for _, c := range n.children {
+		if c.tag == "" {
+			if strings.TrimSpace(c.text) != "" {
+				items = append(items, w.paragraph(w.inline(&node{children: []*node{c}}), width))
+			}
+			continue
+		}
+		if c.tag != "li" {
+			// Lists nested directly in lists belong to the previous item.
+			b := w.block(c, innerWidth(width, 2))
+			if b == "" {
+				continue
+			}
+			if len(items) > 0 {
+				items[len(items)-1] += "\n" + indentLines(b, "  ", "  ")
+			} else {
+				items = append(items, b)
+			}
+			continue
+		}
+		marker := "- "
+		if n.tag == "ol" {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+		// Items without paragraphs are tight, with their text and nested
+		// lists on consecutive lines.
+		separator := "\n"
+		for _, e := range c.children {
+			if e.tag == "p" {
+				separator = "\n\n"
+			}
+		}
+		content := w.joinBlocks(c, innerWidth(width, len(marker)), separator)
+		items = append(items, strings.TrimRight(indentLines(content, marker, strings.Repeat(" ", len(marker))), " "))
+	}
+	return strings.Join(items, "\n")
+}
+
+func (w *writer) table(n *node) string {
+	var rows [][]string
+	var collect func(n *node)
+	collect = func(n *node) {
+		for _, c := range n.children {
+			switch c.tag {
+			case "tr":
+				var row []string
+				for _, cell := range c.children {
+					if cell.tag == "td" || cell.tag == "th" {
+						text := strings.ReplaceAll(w.paragraph(w.inline(cell), 0), "\n", " ")
+						if w.markdown {
+							text = strings.ReplaceAll(text, "|", `\|`)
+						}
+						row = append(row, text)
+					}
+				}
+				rows = append(rows, row)
+			case "thead", "tbody", "tfoot":
+				collect(c)
+			}
+		}
+	}
+	collect(n)
+
+	var aligns []string
+	if t := firstRow(n); t != nil {
+		for _, cell := range t.children {
+			if cell.tag == "td" || cell.tag == "th" {
+				aligns = append(aligns, cell.attr("align"))
+			}
+		}
+	}
+
+	columns := 0
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+	if columns == 0 {
+		return ""
+	}
+	widths := make([]int, columns)
+	if w.markdown {
+		for j := range widths {
+			widths[j] = 3
+		}
+	}
+	for i := range rows {
+		for len(rows[i]) < columns {
+			rows[i] = append(rows[i], "")
+		}
+		for j, cell := range rows[i] {
+			if l := utf8.RuneCountInString(cell); l > widths[j] {
+				widths[j] = l
+			}
+		}
+	}
+
+	var lines []string
+	for i, row := range rows {
+		cells := make([]string, columns)
+		for j, cell := range row {
+			cells[j] = cell + strings.Repeat(" ", widths[j]-utf8.RuneCountInString(cell))
+		}
+		if w.markdown {
+			lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+			if i == 0 {
+				delimiters := make([]string, columns)
+				for j := range delimiters {
+					align := ""
+					if j < len(aligns) {
+						align = aligns[j]
+					}
+					switch align {
+					case "left":
+						delimiters[j] = ":" + strings.Repeat("-", widths[j]-1)
+					case "center":
+						delimiters[j] = ":" + strings.Repeat("-", widths[j]-2) + ":"
+					case "right":
+						delimiters[j] = strings.Repeat("-", widths[j]-1) + ":"
+					default:
+						delimiters[j] = strings.Repeat("-", widths[j])
+					}
+				}
+				lines = append(lines, "| "+strings.Join(delimiters, " | ")+" |")
+			}
+			continue
+		}
+		lines = append(lines, strings.TrimRight(strings.Join(cells, " | "), " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// firstRow returns the first table row.
+func firstRow(n *node) *node {
+	for _, c := range n.children {
+		switch c.tag {
+		case "tr":
+			return c
+		case "thead", "tbody", "tfoot":
+			if r := firstRow(c); r != nil {
+				return r
+			}
+		}
+	}
+	return nil
+}
+
+// inline returns the node children as inline text.
+func (w *writer) inline(n *node) string {
+	var b strings.Builder
+	for _, c := range n.children {
+		w.writeInline(&b, c)
+	}
+	return b.String()
+}
+
+func (w *writer) writeInline(b *strings.Builder, n *node) {
+	if n.tag == "" {
+		text := spacesPattern.ReplaceAllString(n.text, " ")
+		if w.markdown {
+			text = EscapeMarkdown(text)
+		}
+		b.WriteString(text)
+		return
+	}
+	if droppedElements[n.tag] {
+		return
+	}
+	switch n.tag {
+	case "br":
+		b.WriteString("\n")
+	case "a":
+		text := strings.TrimSpace(w.inline(n))
+		href, ok := resolveURL(n.attr("href"), w.base)
+		switch {
+		case !ok:
+			b.WriteString(text)
+		case w.markdown && (text == "" || text == EscapeMarkdown(href)):
+			b.WriteString("<" + href + ">")
+		case w.markdown:
+			b.WriteString("[" + text + "](" + EscapeMarkdownURL(href) + ")")
+		case text == "" || text == href || text == strings.TrimPrefix(href, "mailto:"):
+			b.WriteString(strings.TrimPrefix(href, "mailto:"))
+		default:
+			b.WriteString(text + " (" + href + ")")
+		}
+	case "img":
+		alt := spacesPattern.ReplaceAllString(strings.TrimSpace(n.attr("alt")), " ")
+		src, ok := resolveURL(n.attr("src"), w.base)
+		switch {
+		case w.markdown && ok:
+			b.WriteString("![" + EscapeMarkdown(alt) + "](" + EscapeMarkdownURL(src) + ")")
+		case w.markdown:
+			b.WriteString(EscapeMarkdown(alt))
+		default:
+			b.WriteString(alt)
+		}
+	case "code", "kbd", "samp", "tt":
+		code := spacesPattern.ReplaceAllString(n.textContent(), " ")
+		if w.markdown {
+			code = markdownCode(code)
+		}
+		b.WriteString(code)
+	case "strong", "b":
+		b.WriteString(w.emphasis(n, "**"))
+	case "em", "i":
+		b.WriteString(w.emphasis(n, "*"))
+	case "del", "s", "strike":
+		b.WriteString(w.emphasis(n, "~~"))
+	default:
+		if blockElements[n.tag] {
+			b.WriteString(" " + w.inline(n) + " ")
+			return
+		}
+		b.WriteString(w.inline(n))
+	}
+}
+
+// emphasis returns the inline text of the node surrounded by the Markdown
+// delimiter, keeping surrounding spaces outside of it.
+func (w *writer) emphasis(n *node, delimiter string) string {
+	text := w.inline(n)
+	if !w.markdown {
+		return text
+	}
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	leading := text[:strings.Index(text, trimmed)]
+	trailing := text[len(leading)+len(trimmed):]
+	return leading + delimiter + trimmed + delimiter + trailing
+}
+
+// paragraph returns inline text as a paragraph, with lines separated by
+// line breaks and wrapped at the width if it is greater than zero.
+func (w *writer) paragraph(s string, width int) string {
+	var lines []string
+	for _, l := range strings.Split(s, "\n") {
+		l = strings.TrimSpace(spacesPattern.ReplaceAllString(l, " "))
+		if w.markdown && l != "" {
+			l = escapeLineStart(l)
+		}
+		if width > 0 && l != "" {
+			lines = append(lines, wrap(l, width)...)
+			continue
+		}
+		lines = append(lines, l)
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if w.markdown {
+		return strings.Join(lines, "  \n")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wrap splits the line at spaces into lines that are not longer than the
+// width, except for words that are longer.
+func wrap(line string, width int) (lines []string) {
+	var b strings.Builder
+	length := 0
+	for _, word := range strings.Fields(line) {
+		l := utf8.RuneCountInString(word)
+		if length > 0 && length+1+l > width {
+			lines = append(lines, b.String())
+			b.Reset()
+			length = 0
+		}
+		if length > 0 {
+			b.WriteByte(' ')
+			length++
+		}
+		b.WriteString(word)
+		length += l
+	}
+	if length > 0 {
+		lines = append(lines, b.String())
+	}
+	return lines
+}
+
+// indentLines prefixes the first line with the first prefix and other
+// non-empty lines with the rest prefix.
+func indentLines(s, first, rest string) string {
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		switch {
+		case i == 0:
+			lines[i] = first + l
+		case l != "":
+			lines[i] = rest + l
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func innerWidth(width, indent int) int {
+	if width <= 0 {
+		return 0
+	}
+	return maxInt(width-indent, 1)
+}
+
+func underlineWidth(text string) (n int) {
+	for _, l := range strings.Split(text, "\n") {
+		n = maxInt(n, utf8.RuneCountInString(l))
+	}
+	return n
+}
+
+func codeLanguage(pre *node) string {
+	for _, c := range pre.children {
+		if c.tag == "code" {
+			if class := c.attr("class"); classPattern.MatchString(class) {
+				return strings.TrimPrefix(class, "language-")
+			}
+		}
+	}
+	return ""
+}
+
+// EscapeMarkdown escapes characters that have a meaning in inline Markdown,
+// including the pipe that separates table cells, so that s is written as
+// literal text. Underscores are escaped only at word boundaries.
+func EscapeMarkdown(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\', '*', '`', '[', ']', '<', '~', '|':
+			b.WriteByte('\\')
+		case '_':
+			if i == 0 || i == len(s)-1 || !isWordChar(s[i-1]) || !isWordChar(s[i+1]) {
+				b.WriteByte('\\')
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// escapeLineStart escapes characters at the start of a line that would start
+// a Markdown block.
+func escapeLineStart(l string) string {
+	if strings.IndexByte(markdownLineStartChar, l[0]) >= 0 {
+		return `\` + l
+	}
+	if m := orderedLineStart.FindStringSubmatch(l); m != nil {
+		return m[1] + `\` + l[len(m[1]):]
+	}
+	return l
+}
+
+func markdownCode(code string) string {
+	longest, run := 0, 0
+	for i := 0; i < len(code); i++ {
+		if code[i] == '`' {
+			run++
+			longest = maxInt(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	fence := strings.Repeat("`", longest+1)
+	if strings.HasPrefix(code, "`") || strings.HasSuffix(code, "`") {
+		code = " " + code + " "
+	}
+	return fence + code + fence
+}
+
+var markdownURLReplacer = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29", "<", "%3C", ">", "%3E")
+
+// EscapeMarkdownURL escapes characters that would end a Markdown link
+// destination, so that u can be written in parentheses after the link text.
+func EscapeMarkdownURL(u string) string {
+	return markdownURLReplacer.Replace(u)
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render_test
+
+import (
+	"testing"
+
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/render"
+)
+
+const textDocument = `<h1>Release 1.1</h1>
+<p>This release adds <strong>streaming</strong> support and fixes a <em>rare</em> crash in the <code>parse_all</code> function, see <a href="/acme/app/pull/12">#12</a>.</p>
+<h3>Upgrade</h3>
+<ol start="2"><li>Stop the server.</li><li><p>Run the migration:</p><pre><code class="language-sh">app migrate --all
+app check</code></pre></li></ol>
+<blockquote><p>Back up *first*.</p></blockquote>
+<table><tr><th>Option</th><th align="right">Default</th></tr><tr><td>timeout</td><td align="right">30s</td></tr></table>
+<hr>
+<p>Thanks <img src="/heart.png" alt="love"> to everyone!<br>The team</p>`
+
+func TestDocument_Markdown(t *testing.T) {
+	got := render.ParseFormat(textDocument, render.FormatHTML, "https://github.com/acme/app/releases/tag/v1.1.0").Markdown()
+
+	testutil.AssertEqual(t, "", got, "# Release 1.1\n"+
+		"\n"+
+		"This release adds **streaming** support and fixes a *rare* crash in the `parse_all` function, see [#12](https://github.com/acme/app/pull/12).\n"+
+		"\n"+
+		"### Upgrade\n"+
+		"\n"+
+		"2. Stop the server.\n"+
+		"3. Run the migration:\n"+
+		"\n"+
+		"   ```sh\n"+
+		"   app migrate --all\n"+
+		"   app check\n"+
+		"   ```\n"+
+		"\n"+
+		"> Back up \\*first\\*.\n"+
+		"\n"+
+		"| Option  | Default |\n"+
+		"| ------- | ------: |\n"+
+		"| timeout | 30s     |\n"+
+		"\n"+
+		"---\n"+
+		"\n"+
+		"Thanks ![love](https://github.com/heart.png) to everyone!  \n"+
+		"The team")
+}
+
+func TestDocument_Text(t *testing.T) {
+	got := render.ParseFormat(textDocument, render.FormatHTML, "https://github.com/acme/app/releases/tag/v1.1.0").Text()
+
+	testutil.AssertEqual(t, "", got, `Release 1.1
+===========
+
+This release adds streaming support and fixes a rare crash in the parse_all function, see #12 (https://github.com/acme/app/pull/12).
+
+Upgrade
+
+2. Stop the server.
+3. Run the migration:
+
+       app migrate --all
+       app check
+
+> Back up *first*.
+
+Option  | Default
+timeout | 30s
+
+---
+
+Thanks love to everyone!
+The team`)
+}
+
+func TestDocument_Terminal(t *testing.T) {
+	got := render.ParseFormat(textDocument, render.FormatHTML, "https://github.com/acme/app/releases/tag/v1.1.0").Terminal(32)
+
+	testutil.AssertEqual(t, "", got, `Release 1.1
+===========
+
+This release adds streaming
+support and fixes a rare crash
+in the parse_all function, see
+#12
+(https://github.com/acme/app/pull/12).
+
+Upgrade
+
+2. Stop the server.
+3. Run the migration:
+
+       app migrate --all
+       app check
+
+> Back up *first*.
+
+Option  | Default
+timeout | 30s
+
+--------------------------------
+
+Thanks love to everyone!
+The team`)
+}
+
+func TestDocument_Markdown_escape(t *testing.T) {
+	got := render.ParseFormat("<p>1. not a list</p><p># not a heading</p><p>a_b _c_ [d] `e` *f* &lt;g&gt;</p>", render.FormatHTML, "").Markdown()
+
+	testutil.AssertEqual(t, "", got, "1\\. not a list\n\n\\# not a heading\n\na_b \\_c\\_ \\[d\\] \\`e\\` \\*f\\* \\<g>")
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	got := render.EscapeMarkdown("curl-8_4_0 _a_ [b] *c* `d` <e> f|g ~h \\")
+
+	testutil.AssertEqual(t, "", got, "curl-8_4_0 \\_a\\_ \\[b\\] \\*c\\* \\`d\\` \\<e> f\\|g \\~h \\\\")
+}
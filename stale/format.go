@@ -0,0 +1,82 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stale
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/render"
+)
+
+// WriteMarkdown writes the report as a Markdown document with a table for
+// every tag and a summary of suggestions.
+func (r *Report) WriteMarkdown(w io.Writer) (err error) {
+	var b strings.Builder
+	b.WriteString("# Stale projects\n\n")
+	fmt.Fprintf(&b, "Projects without a release in %d days", days(r.Threshold))
+	if r.CadenceDrop > 0 {
+		fmt.Fprintf(&b, ", or with the time since the latest release more than %s times their usual interval", formatFloat(r.CadenceDrop))
+	}
+	fmt.Fprintf(&b, ", as of %s.\n", r.Now.UTC().Format(render.DateFormat))
+
+	if len(r.Groups) == 0 {
+		b.WriteString("\nNo stale projects.\n")
+	}
+	for _, g := range r.Groups {
+		name := g.Tag.Name
+		if g.Tag.ID == "" {
+			name = "Untagged"
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", render.EscapeMarkdown(name))
+		b.WriteString("| Project | Latest release | Age | Reason | Suggestion |\n")
+		b.WriteString("| --- | --- | --- | --- | --- |\n")
+		for _, p := range g.Projects {
+			latest, age := "-", "-"
+			if p.Latest != nil {
+				latest = render.EscapeMarkdown(p.Latest.Version) + " (" + p.Latest.Date.UTC().Format(render.DateFormat) + ")"
+				age = fmt.Sprintf("%d days", days(p.Age))
+			}
+			reason := p.Reason.String()
+			if p.Reason == ReasonCadenceDrop {
+				reason += fmt.Sprintf(" (%s× usual interval)", formatFloat(p.Overdue))
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", projectLink(p.Project), latest, age, reason, p.Suggestion)
+		}
+	}
+
+	if len(r.Groups) > 0 {
+		b.WriteString("\n## Suggestions\n\n")
+		for _, s := range []Suggestion{SuggestionRemove, SuggestionReplace, SuggestionReview} {
+			fmt.Fprintf(&b, "- %s: %d\n", s, len(r.Candidates(s)))
+		}
+	}
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+func projectLink(p newreleases.Project) string {
+	text := render.EscapeMarkdown(string(p.Provider) + "/" + p.Name)
+	u := p.URL
+	if u == "" {
+		u, _ = newreleases.ProjectURL(p.Provider, p.Name)
+	}
+	if u == "" {
+		return text
+	}
+	return "[" + text + "](" + u + ")"
+}
+
+func days(d time.Duration) int {
+	return int(d / (24 * time.Hour))
+}
+
+func formatFloat(f float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.1f", f), "0"), ".")
+}
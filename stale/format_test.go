@@ -0,0 +1,122 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stale_test
+
+import (
+	"strings"
+	"testing"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/stale"
+)
+
+func TestReport_WriteMarkdown(t *testing.T) {
+	now := testutil.Date("2023-06-01")
+	old := stale.Project{
+		Project:    newreleases.Project{ID: "old", Provider: "github", Name: "acme/old_lib"},
+		Latest:     &newreleases.Release{Version: "v0.1.0|beta", Date: testutil.Date("2020-01-01")},
+		Age:        now.Sub(testutil.Date("2020-01-01")),
+		Reason:     stale.ReasonStale,
+		Suggestion: stale.SuggestionRemove,
+	}
+	r := &stale.Report{
+		Now:         now,
+		Threshold:   stale.DefaultThreshold,
+		CadenceDrop: 2.5,
+		Groups: []stale.Group{
+			{Tag: newreleases.Tag{ID: "t1", Name: "backend"}, Projects: []stale.Project{
+				{
+					Project:    newreleases.Project{ID: "empty", Provider: "npm", Name: "empty", URL: "https://www.npmjs.com/package/empty"},
+					Reason:     stale.ReasonNoReleases,
+					Suggestion: stale.SuggestionRemove,
+				},
+				old,
+			}},
+			{Projects: []stale.Project{
+				old,
+				{
+					Project:    newreleases.Project{ID: "slowing", Provider: "custom", Name: "slowing"},
+					Latest:     &newreleases.Release{Version: "1.5.0", Date: testutil.Date("2023-01-01")},
+					Age:        now.Sub(testutil.Date("2023-01-01")),
+					Reason:     stale.ReasonCadenceDrop,
+					Overdue:    151.0 / 31,
+					Suggestion: stale.SuggestionReview,
+				},
+			}},
+		},
+	}
+
+	var b strings.Builder
+	if err := r.WriteMarkdown(&b); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "", b.String(), `# Stale projects
+
+Projects without a release in 365 days, or with the time since the latest release more than 2.5 times their usual interval, as of 2023-06-01.
+
+## backend
+
+| Project | Latest release | Age | Reason | Suggestion |
+| --- | --- | --- | --- | --- |
+| [npm/empty](https://www.npmjs.com/package/empty) | - | - | no releases | remove |
+| [github/acme/old_lib](https://github.com/acme/old_lib/releases) | v0.1.0\|beta (2020-01-01) | 1247 days | stale | remove |
+
+## Untagged
+
+| Project | Latest release | Age | Reason | Suggestion |
+| --- | --- | --- | --- | --- |
+| [github/acme/old_lib](https://github.com/acme/old_lib/releases) | v0.1.0\|beta (2020-01-01) | 1247 days | stale | remove |
+| custom/slowing | 1.5.0 (2023-01-01) | 151 days | cadence drop (4.9× usual interval) | review |
+
+## Suggestions
+
+- remove: 2
+- replace: 0
+- review: 1
+`)
+}
+
+func TestReport_WriteMarkdown_empty(t *testing.T) {
+	r := &stale.Report{
+		Now:         testutil.Date("2023-06-01"),
+		Threshold:   stale.DefaultThreshold,
+		CadenceDrop: -1,
+	}
+
+	var b strings.Builder
+	if err := r.WriteMarkdown(&b); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, "", b.String(), `# Stale projects
+
+Projects without a release in 365 days, as of 2023-06-01.
+
+No stale projects.
+`)
+}
+
+func TestReason_String(t *testing.T) {
+	for r, want := range map[stale.Reason]string{
+		stale.ReasonNoReleases:  "no releases",
+		stale.ReasonStale:       "stale",
+		stale.ReasonCadenceDrop: "cadence drop",
+		stale.Reason(0):         "unknown",
+	} {
+		testutil.AssertEqual(t, "", r.String(), want)
+	}
+}
+
+func TestSuggestion_String(t *testing.T) {
+	for s, want := range map[stale.Suggestion]string{
+		stale.SuggestionReview:  "review",
+		stale.SuggestionReplace: "replace",
+		stale.SuggestionRemove:  "remove",
+		stale.Suggestion(0):     "unknown",
+	} {
+		testutil.AssertEqual(t, "", s.String(), want)
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package stale detects tracked projects that have not released for a long
+// time or whose release cadence has dropped compared with their history, and
+// suggests candidates for removal or replacement, grouped by tag.
+package stale // import "newreleases.io/newreleases/stale"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/cadence"
+)
+
+// Defaults for Options.
+const (
+	DefaultThreshold   = 365 * 24 * time.Hour
+	DefaultCadenceDrop = 3.0
+)
+
+// minIntervals is the minimal number of intervals between releases for the
+// release history to be compared with the time since the last release.
+const minIntervals = 4
+
+// Reason describes why a project is reported.
+type Reason int
+
+// Reasons for reporting a project.
+const (
+	// ReasonNoReleases is for projects without any releases.
+	ReasonNoReleases Reason = iota + 1
+	// ReasonStale is for projects with the latest release older than the
+	// threshold.
+	ReasonStale
+	// ReasonCadenceDrop is for projects with the time since the latest
+	// release much longer than their usual interval between releases.
+	ReasonCadenceDrop
+)
+
+func (r Reason) String() string {
+	switch r {
+	case ReasonNoReleases:
+		return "no releases"
+	case ReasonStale:
+		return "stale"
+	case ReasonCadenceDrop:
+		return "cadence drop"
+	}
+	return "unknown"
+}
+
+// Suggestion is a suggested action for a reported project.
+type Suggestion int
+
+// Suggested actions, from the least to the most severe.
+const (
+	// SuggestionReview suggests checking whether the project is still
+	// maintained, as it releases less often than it used to.
+	SuggestionReview Suggestion = iota + 1
+	// SuggestionReplace suggests looking for a maintained alternative, as
+	// the project has not released for longer than the threshold.
+	SuggestionReplace
+	// SuggestionRemove suggests removing the project, as it has never
+	// released or has not released for more than twice the threshold.
+	SuggestionRemove
+)
+
+func (s Suggestion) String() string {
+	switch s {
+	case SuggestionReview:
+		return "review"
+	case SuggestionReplace:
+		return "replace"
+	case SuggestionRemove:
+		return "remove"
+	}
+	return "unknown"
+}
+
+// Project holds information about a reported project.
+type Project struct {
+	Project newreleases.Project
+	// Latest is the latest non-excluded release, nil if there are no
+	// releases.
+	Latest *newreleases.Release
+	// Age is the time since the latest release.
+	Age    time.Duration
+	Reason Reason
+	// Overdue is the time since the latest release that is not a prerelease
+	// divided by the median interval between such releases, zero if the
+	// release history was not checked.
+	Overdue    float64
+	Suggestion Suggestion
+}
+
+// Group holds reported projects with a tag, ordered from the most severe
+// suggestion and the oldest latest release.
+type Group struct {
+	// Tag is zero for projects without tags.
+	Tag      newreleases.Tag
+	Projects []Project
+}
+
+// Report holds reported projects grouped by tags, in the order that tags
+// are listed, followed by projects without tags. A project with multiple tags
+// is in multiple groups. Groups without reported projects are omitted.
+type Report struct {
+	Now         time.Time
+	Threshold   time.Duration
+	CadenceDrop float64
+	Groups      []Group
+}
+
+// Candidates returns reported projects with the suggestion, without
+// duplicates, in the order of groups.
+func (r *Report) Candidates(s Suggestion) (projects []Project) {
+	seen := make(map[string]struct{})
+	for _, g := range r.Groups {
+		for _, p := range g.Projects {
+			if p.Suggestion != s {
+				continue
+			}
+			if _, ok := seen[p.Project.ID]; ok {
+				continue
+			}
+			seen[p.Project.ID] = struct{}{}
+			projects = append(projects, p)
+		}
+	}
+	return projects
+}
+
+// Options holds optional parameters for Detect.
+type Options struct {
+	// Threshold is the age of the latest release after which a project is
+	// stale, DefaultThreshold if it is not set.
+	Threshold time.Duration
+	// CadenceDrop is the ratio of the time since the latest release to the
+	// median interval between releases above which a project is reported,
+	// DefaultCadenceDrop if it is zero. The release history is not checked if
+	// it is negative.
+	CadenceDrop float64
+	// TagID limits detection to projects with the tag.
+	TagID string
+	// Provider limits detection to projects of the provider.
+	Provider newreleases.Provider
+	// MaxPages limits the number of listed release pages for every project
+	// whose release history is checked. All pages are listed if it is zero.
+	MaxPages int
+	// Now is the time of the detection, the current time if it is zero.
+	Now time.Time
+}
+
+// Detect checks the latest release of tracked projects and reports projects
+// without releases and projects with the latest release older than the
+// threshold. For other projects, the release history is listed to report
+// projects whose time since the latest release is more than CadenceDrop times
+// longer than their median interval between releases.
+func Detect(ctx context.Context, client *newreleases.Client, o *Options) (r *Report, err error) {
+	if o == nil {
+		o = new(Options)
+	}
+	r = &Report{
+		Now:         o.Now,
+		Threshold:   o.Threshold,
+		CadenceDrop: o.CadenceDrop,
+	}
+	if r.Now.IsZero() {
+		r.Now = time.Now()
+	}
+	if r.Threshold <= 0 {
+		r.Threshold = DefaultThreshold
+	}
+	if r.CadenceDrop == 0 {
+		r.CadenceDrop = DefaultCadenceDrop
+	}
+
+	tags, err := client.Tags.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups := make(map[string]*Group, len(tags))
+	for _, t := range tags {
+		groups[t.ID] = &Group{Tag: t}
+	}
+	untagged := new(Group)
+
+	err = client.Projects.Walk(ctx, newreleases.ProjectListOptions{
+		Provider: o.Provider,
+		TagID:    o.TagID,
+	}, func(p newreleases.Project) (stop bool, err error) {
+		s, err := r.check(ctx, client, p, o)
+		if err != nil {
+			return false, fmt.Errorf("%s/%s: %w", p.Provider, p.Name, err)
+		}
+		if s == nil {
+			return false, nil
+		}
+		tagged := false
+		for _, id := range p.TagIDs {
+			if g, ok := groups[id]; ok {
+				g.Projects = append(g.Projects, *s)
+				tagged = true
+			}
+		}
+		if !tagged {
+			untagged.Projects = append(untagged.Projects, *s)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tags {
+		if g := groups[t.ID]; len(g.Projects) > 0 {
+			r.Groups = append(r.Groups, *g)
+		}
+	}
+	if len(untagged.Projects) > 0 {
+		r.Groups = append(r.Groups, *untagged)
+	}
+	for _, g := range r.Groups {
+		sortProjects(g.Projects)
+	}
+	return r, nil
+}
+
+// check returns a reported project, or nil if the project is not reported.
+func (r *Report) check(ctx context.Context, client *newreleases.Client, p newreleases.Project, o *Options) (s *Project, err error) {
+	latest, err := client.Releases.GetLatestByProjectID(ctx, p.ID)
+	if errors.Is(err, newreleases.ErrNotFound) {
+		return &Project{
+			Project:    p,
+			Reason:     ReasonNoReleases,
+			Suggestion: SuggestionRemove,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s = &Project{
+		Project: p,
+		Latest:  latest,
+		Age:     r.Now.Sub(latest.Date),
+	}
+	switch {
+	case s.Age > 2*r.Threshold:
+		s.Reason, s.Suggestion = ReasonStale, SuggestionRemove
+		return s, nil
+	case s.Age > r.Threshold:
+		s.Reason, s.Suggestion = ReasonStale, SuggestionReplace
+		return s, nil
+	case r.CadenceDrop < 0:
+		return nil, nil
+	}
+
+	releases, err := client.Releases.ListAll(ctx, p.Ref(), o.MaxPages)
+	if err != nil {
+		return nil, err
+	}
+	stats := cadence.Compute(releases)
+	if stats.Intervals[cadence.KindAll].Count < minIntervals {
+		return nil, nil
+	}
+	if s.Overdue = stats.Overdue(r.Now); s.Overdue <= r.CadenceDrop {
+		return nil, nil
+	}
+	s.Reason, s.Suggestion = ReasonCadenceDrop, SuggestionReview
+	return s, nil
+}
+
+func sortProjects(projects []Project) {
+	sort.SliceStable(projects, func(i, j int) bool {
+		a, b := projects[i], projects[j]
+		if a.Suggestion != b.Suggestion {
+			return a.Suggestion > b.Suggestion
+		}
+		if (a.Latest == nil) != (b.Latest == nil) {
+			return a.Latest == nil
+		}
+		return a.Age > b.Age
+	})
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stale_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"newreleases.io/newreleases"
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/stale"
+)
+
+func TestDetect(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/tags", `{"tags": [
+		{"id": "t1", "name": "backend"},
+		{"id": "t2", "name": "frontend"},
+		{"id": "t3", "name": "tools"}
+	]}`)
+	testutil.Handle(mux, "/v1/projects", `{"projects": [
+		{"id": "empty", "provider": "npm", "name": "empty", "tags": ["t1"]},
+		{"id": "old", "provider": "github", "name": "acme/old", "tags": ["t1", "t2"]},
+		{"id": "aging", "provider": "npm", "name": "aging", "tags": ["t2"]},
+		{"id": "slowing", "provider": "npm", "name": "slowing"},
+		{"id": "active", "provider": "npm", "name": "active", "tags": ["t1", "t3"]},
+		{"id": "young", "provider": "npm", "name": "young", "tags": ["t3"]}
+	], "total_pages": 1}`)
+	mux.HandleFunc("/v1/projects/empty/latest-release", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	testutil.Handle(mux, "/v1/projects/old/latest-release", `{"version": "v0.1.0", "date": "2020-01-01T00:00:00Z"}`)
+	testutil.Handle(mux, "/v1/projects/aging/latest-release", `{"version": "2.0.0", "date": "2022-01-01T00:00:00Z"}`)
+	testutil.Handle(mux, "/v1/projects/slowing/latest-release", `{"version": "1.5.0", "date": "2023-01-01T00:00:00Z"}`)
+	testutil.Handle(mux, "/v1/projects/slowing/releases", `{"releases": [
+		{"version": "1.5.0", "date": "2023-01-01T00:00:00Z"},
+		{"version": "1.4.0", "date": "2022-12-01T00:00:00Z"},
+		{"version": "1.3.0", "date": "2022-11-01T00:00:00Z"},
+		{"version": "1.2.0", "date": "2022-10-01T00:00:00Z"},
+		{"version": "1.1.0", "date": "2022-09-01T00:00:00Z"},
+		{"version": "1.0.0", "date": "2022-08-01T00:00:00Z"}
+	], "total_pages": 1}`)
+	testutil.Handle(mux, "/v1/projects/active/latest-release", `{"version": "3.0.5", "date": "2023-05-25T00:00:00Z"}`)
+	testutil.Handle(mux, "/v1/projects/active/releases", `{"releases": [
+		{"version": "3.0.5", "date": "2023-05-25T00:00:00Z"},
+		{"version": "3.0.4", "date": "2023-05-18T00:00:00Z"},
+		{"version": "3.0.3", "date": "2023-05-11T00:00:00Z"},
+		{"version": "3.0.2", "date": "2023-05-04T00:00:00Z"},
+		{"version": "3.0.1", "date": "2023-04-27T00:00:00Z"}
+	], "total_pages": 1}`)
+	testutil.Handle(mux, "/v1/projects/young/latest-release", `{"version": "0.2.0", "date": "2023-01-01T00:00:00Z"}`)
+	testutil.Handle(mux, "/v1/projects/young/releases", `{"releases": [
+		{"version": "0.2.0", "date": "2023-01-01T00:00:00Z"},
+		{"version": "0.1.0", "date": "2022-12-25T00:00:00Z"}
+	], "total_pages": 1}`)
+
+	now := testutil.Date("2023-06-01")
+	got, err := stale.Detect(context.Background(), client, &stale.Options{Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	empty := stale.Project{
+		Project:    newreleases.Project{ID: "empty", Provider: "npm", Name: "empty", TagIDs: []string{"t1"}},
+		Reason:     stale.ReasonNoReleases,
+		Suggestion: stale.SuggestionRemove,
+	}
+	old := stale.Project{
+		Project:    newreleases.Project{ID: "old", Provider: "github", Name: "acme/old", TagIDs: []string{"t1", "t2"}},
+		Latest:     &newreleases.Release{Version: "v0.1.0", Date: testutil.Date("2020-01-01")},
+		Age:        now.Sub(testutil.Date("2020-01-01")),
+		Reason:     stale.ReasonStale,
+		Suggestion: stale.SuggestionRemove,
+	}
+	aging := stale.Project{
+		Project:    newreleases.Project{ID: "aging", Provider: "npm", Name: "aging", TagIDs: []string{"t2"}},
+		Latest:     &newreleases.Release{Version: "2.0.0", Date: testutil.Date("2022-01-01")},
+		Age:        now.Sub(testutil.Date("2022-01-01")),
+		Reason:     stale.ReasonStale,
+		Suggestion: stale.SuggestionReplace,
+	}
+	slowing := stale.Project{
+		Project:    newreleases.Project{ID: "slowing", Provider: "npm", Name: "slowing"},
+		Latest:     &newreleases.Release{Version: "1.5.0", Date: testutil.Date("2023-01-01")},
+		Age:        now.Sub(testutil.Date("2023-01-01")),
+		Reason:     stale.ReasonCadenceDrop,
+		Overdue:    151.0 / 31,
+		Suggestion: stale.SuggestionReview,
+	}
+	testutil.AssertEqual(t, "", got, &stale.Report{
+		Now:         now,
+		Threshold:   stale.DefaultThreshold,
+		CadenceDrop: stale.DefaultCadenceDrop,
+		Groups: []stale.Group{
+			{Tag: newreleases.Tag{ID: "t1", Name: "backend"}, Projects: []stale.Project{empty, old}},
+			{Tag: newreleases.Tag{ID: "t2", Name: "frontend"}, Projects: []stale.Project{old, aging}},
+			{Projects: []stale.Project{slowing}},
+		},
+	})
+
+	testutil.AssertEqual(t, "remove", got.Candidates(stale.SuggestionRemove), []stale.Project{empty, old})
+	testutil.AssertEqual(t, "replace", got.Candidates(stale.SuggestionReplace), []stale.Project{aging})
+	testutil.AssertEqual(t, "review", got.Candidates(stale.SuggestionReview), []stale.Project{slowing})
+}
+
+func TestDetect_noCadence(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/tags", `{"tags": []}`)
+	mux.HandleFunc("/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag"); got != "t1" {
+			t.Errorf("got tag %q, want %q", got, "t1")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintln(w, `{"projects": [
+			{"id": "app", "provider": "npm", "name": "app", "tags": ["t1"]}
+		], "total_pages": 1}`)
+	})
+	testutil.Handle(mux, "/v1/projects/app/latest-release", `{"version": "1.0.0", "date": "2023-01-01T00:00:00Z"}`)
+	var releaseRequests int32
+	mux.HandleFunc("/v1/projects/app/releases", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&releaseRequests, 1)
+	})
+
+	got, err := stale.Detect(context.Background(), client, &stale.Options{
+		Threshold:   100 * 24 * time.Hour,
+		CadenceDrop: -1,
+		TagID:       "t1",
+		Now:         testutil.Date("2023-06-01"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, "groups", got.Groups, []stale.Group{
+		{Projects: []stale.Project{{
+			Project:    newreleases.Project{ID: "app", Provider: "npm", Name: "app", TagIDs: []string{"t1"}},
+			Latest:     &newreleases.Release{Version: "1.0.0", Date: testutil.Date("2023-01-01")},
+			Age:        151 * 24 * time.Hour,
+			Reason:     stale.ReasonStale,
+			Suggestion: stale.SuggestionReplace,
+		}}},
+	})
+	testutil.AssertEqual(t, "release requests", atomic.LoadInt32(&releaseRequests), int32(0))
+}
+
+func TestDetect_error(t *testing.T) {
+	client, mux, teardown := testutil.NewClient(t)
+	defer teardown()
+
+	testutil.Handle(mux, "/v1/tags", `{"tags": []}`)
+	testutil.Handle(mux, "/v1/projects", `{"projects": [{"id": "app", "provider": "npm", "name": "app"}], "total_pages": 1}`)
+	mux.HandleFunc("/v1/projects/app/latest-release", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := stale.Detect(context.Background(), client, nil)
+	testutil.AssertEqual(t, "", err.Error(), "npm/app: "+newreleases.ErrInternalServerError.Error())
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package version
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidConstraint is returned when a constraint can not be parsed.
+var ErrInvalidConstraint = errors.New("invalid version constraint")
+
+// Constraint is a parsed version constraint. It is a set of alternatives
+// separated by ||, each of which is a list of comparisons that all must be
+// satisfied, separated by spaces or commas.
+//
+// Supported comparisons are =, !=, >, >=, <, <=, tilde ranges (~1.4 matches
+// 1.4.x), Ruby pessimistic ranges (~> 1.4 matches 1.x from 1.4), caret ranges
+// (^3 matches 3.x.x, ^0.2.3 matches 0.2.x from 0.2.3), wildcards (1.2.x, 1.*
+// or *) and hyphen ranges (1.2 - 1.4). A partial version without an operator,
+// like 1.2, matches all versions with the same leading segments.
+type Constraint struct {
+	groups   [][]comparison
+	original string
+}
+
+type comparison struct {
+	op string // one of =, !=, >, >=, <, <=
+	v  Version
+	// ceiling is set for the synthetic bounds of partial versions, which are
+	// not considered by the prerelease check as they do not come from the
+	// constraint itself.
+	ceiling bool
+}
+
+// ParseConstraint parses a version constraint.
+func ParseConstraint(s string) (c *Constraint, err error) {
+	c = &Constraint{original: s}
+	for _, group := range strings.Split(s, "||") {
+		comparisons, err := parseGroup(group)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %v", ErrInvalidConstraint, s, err)
+		}
+		c.groups = append(c.groups, comparisons)
+	}
+	return c, nil
+}
+
+// MustParseConstraint parses a version constraint and panics if it is not
+// valid.
+func MustParseConstraint(s string) (c *Constraint) {
+	c, err := ParseConstraint(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func (c *Constraint) String() string {
+	return c.original
+}
+
+// Check reports whether the version satisfies the constraint. As in npm, a
+// prerelease version satisfies it only if one of the comparisons in the
+// matching alternative has a prerelease version with the same numeric
+// segments, so ">=1.2.0-rc.1" matches 1.2.0-rc.2, but ">=1.0" does not match
+// 2.0.0-rc.1.
+func (c *Constraint) Check(v Version) bool {
+	for _, group := range c.groups {
+		if !matchGroup(group, v) {
+			continue
+		}
+		if !v.IsPrerelease() {
+			return true
+		}
+		for _, cmp := range group {
+			if !cmp.ceiling && cmp.v.IsPrerelease() && sameSegments(cmp.v, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Contains reports whether the version is within the constraint ranges by
+// version ordering only, so prerelease versions are matched like any other.
+func (c *Constraint) Contains(v Version) bool {
+	for _, group := range c.groups {
+		if matchGroup(group, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchGroup(group []comparison, v Version) bool {
+	for _, cmp := range group {
+		if !cmp.match(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c comparison) match(v Version) bool {
+	r := v.Compare(c.v)
+	switch c.op {
+	case "=":
+		return r == 0
+	case "!=":
+		return r != 0
+	case ">":
+		return r > 0
+	case ">=":
+		return r >= 0
+	case "<":
+		return r < 0
+	case "<=":
+		return r <= 0
+	}
+	return false
+}
+
+func sameSegments(a, b Version) bool {
+	n := len(a.Segments)
+	if len(b.Segments) > n {
+		n = len(b.Segments)
+	}
+	for i := 0; i < n; i++ {
+		if a.Segment(i) != b.Segment(i) {
+			return false
+		}
+	}
+	return true
+}
+
+var operators = []string{"~>", ">=", "<=", "!=", "==", "=", ">", "<", "~", "^"}
+
+func parseGroup(s string) (comparisons []comparison, err error) {
+	tokens := strings.Fields(strings.ReplaceAll(s, ",", " "))
+
+	// Join operators separated from versions by spaces, like ">= 1.2".
+	for i := 0; i < len(tokens); i++ {
+		for _, op := range operators {
+			if tokens[i] == op && i+1 < len(tokens) {
+				tokens[i] += tokens[i+1]
+				tokens = append(tokens[:i+1], tokens[i+2:]...)
+				break
+			}
+		}
+	}
+
+	// Hyphen ranges, like "1.2 - 1.4".
+	for i := 0; i+2 < len(tokens); i++ {
+		if tokens[i+1] != "-" {
+			continue
+		}
+		lower, err := parsePartial(tokens[i])
+		if err != nil {
+			return nil, err
+		}
+		upper, err := parsePartial(tokens[i+2])
+		if err != nil {
+			return nil, err
+		}
+		comparisons = append(comparisons, comparison{op: ">=", v: lower.floor()})
+		if upper.full() {
+			comparisons = append(comparisons, comparison{op: "<=", v: upper.v})
+		} else if upper.n > 0 {
+			comparisons = append(comparisons, comparison{op: "<", v: upper.ceiling(upper.n), ceiling: true})
+		}
+		tokens = append(tokens[:i], tokens[i+3:]...)
+		i--
+	}
+
+	for _, token := range tokens {
+		c, err := parseComparison(token)
+		if err != nil {
+			return nil, err
+		}
+		comparisons = append(comparisons, c...)
+	}
+	return comparisons, nil
+}
+
+func parseComparison(token string) (comparisons []comparison, err error) {
+	op := ""
+	for _, o := range operators {
+		if strings.HasPrefix(token, o) {
+			op = o
+			break
+		}
+	}
+	if op != "" && token == op {
+		return nil, fmt.Errorf("missing version after %q", op)
+	}
+	p, err := parsePartial(strings.TrimPrefix(token, op))
+	if err != nil {
+		return nil, err
+	}
+
+	if op == "==" {
+		op = "="
+	}
+	switch op {
+	case "", "=":
+		if p.n == 0 {
+			return nil, nil
+		}
+		if p.full() {
+			return []comparison{{op: "=", v: p.v}}, nil
+		}
+		return p.rangeUpTo(p.n), nil
+	case "!=":
+		return []comparison{{op: "!=", v: p.v}}, nil
+	case ">":
+		if p.full() {
+			return []comparison{{op: ">", v: p.v}}, nil
+		}
+		if p.n == 0 {
+			return []comparison{{op: "<", v: Version{Segments: []int{0}}}}, nil
+		}
+		return []comparison{{op: ">=", v: p.ceiling(p.n), ceiling: true}}, nil
+	case ">=":
+		return []comparison{{op: ">=", v: p.floor()}}, nil
+	case "<":
+		return []comparison{{op: "<", v: p.floor()}}, nil
+	case "<=":
+		if p.full() || p.n == 0 {
+			return []comparison{{op: "<=", v: p.v}}, nil
+		}
+		return []comparison{{op: "<", v: p.ceiling(p.n), ceiling: true}}, nil
+	case "~":
+		switch p.n {
+		case 0:
+			return nil, nil
+		case 1:
+			return p.rangeUpTo(1), nil
+		default:
+			return p.rangeUpTo(2), nil
+		}
+	case "~>":
+		switch p.n {
+		case 0:
+			return nil, nil
+		case 1:
+			return p.rangeUpTo(1), nil
+		default:
+			return p.rangeUpTo(p.n - 1), nil
+		}
+	case "^":
+		if p.n == 0 {
+			return nil, nil
+		}
+		// The range is up to the first non-zero segment, or up to the last
+		// specified one if all of them are zeros.
+		k := p.n
+		for i := 0; i < p.n; i++ {
+			if p.v.Segments[i] != 0 {
+				k = i + 1
+				break
+			}
+		}
+		return p.rangeUpTo(k), nil
+	}
+	return nil, fmt.Errorf("unknown operator in %q", token)
+}
+
+// partial is a version with n specified numeric segments, while the others
+// are missing or wildcards.
+type partial struct {
+	v        Version
+	n        int
+	wildcard bool
+}
+
+func parsePartial(s string) (p partial, err error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "*" || s == "x" || s == "X" {
+		return partial{v: Version{Segments: []int{0}}, wildcard: true}, nil
+	}
+
+	// Replace wildcard segments and everything after them.
+	parts := strings.Split(s, ".")
+	for i, part := range parts {
+		if part == "*" || part == "x" || part == "X" {
+			if i == 0 {
+				return partial{v: Version{Segments: []int{0}}, wildcard: true}, nil
+			}
+			p.wildcard = true
+			s = strings.Join(parts[:i], ".")
+			break
+		}
+	}
+
+	p.v, err = Parse(s)
+	if err != nil {
+		return partial{}, err
+	}
+	p.n = len(p.v.Segments)
+	if p.wildcard && (p.v.Prerelease != "" || p.v.Suffix != "") {
+		return partial{}, fmt.Errorf("invalid wildcard version %q", s)
+	}
+	return p, nil
+}
+
+// full reports whether the partial version specifies at least three numeric
+// segments, or a prerelease, and no wildcards.
+func (p partial) full() bool {
+	return !p.wildcard && (p.n >= 3 || p.v.Prerelease != "")
+}
+
+// floor returns the lowest version that matches the partial version.
+func (p partial) floor() Version {
+	if p.full() {
+		return p.v
+	}
+	return Version{Segments: append([]int(nil), p.v.Segments...)}
+}
+
+// ceiling returns the lowest version that is greater than all versions with
+// the same first k segments. The returned version has the lowest possible
+// prerelease, so that prereleases of the ceiling version are not in range.
+func (p partial) ceiling(k int) Version {
+	segments := make([]int, k)
+	copy(segments, p.v.Segments)
+	segments[k-1]++
+	return Version{Segments: segments, Prerelease: "0"}
+}
+
+// rangeUpTo returns comparisons for versions from the partial version up to,
+// but not including, the next version of the first k segments.
+func (p partial) rangeUpTo(k int) []comparison {
+	return []comparison{
+		{op: ">=", v: p.floor()},
+		{op: "<", v: p.ceiling(k), ceiling: true},
+	}
+}
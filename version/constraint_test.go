@@ -0,0 +1,180 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package version_test
+
+import (
+	"errors"
+	"testing"
+
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/version"
+)
+
+func TestConstraint_Check(t *testing.T) {
+	for _, tc := range []struct {
+		constraint string
+		match      []string
+		noMatch    []string
+	}{
+		{
+			constraint: ">=1.2 <2",
+			match:      []string{"1.2", "1.2.0", "v1.5.3", "1.99.99"},
+			noMatch:    []string{"1.1.9", "2.0.0", "2.0.0-rc.1", "1.5.0-beta"},
+		},
+		{
+			constraint: ">= 1.2, < 2",
+			match:      []string{"1.2.0", "1.9"},
+			noMatch:    []string{"2.0"},
+		},
+		{
+			constraint: "~1.4",
+			match:      []string{"1.4.0", "1.4.9"},
+			noMatch:    []string{"1.3.9", "1.5.0"},
+		},
+		{
+			constraint: "~1.4.2",
+			match:      []string{"1.4.2", "1.4.10"},
+			noMatch:    []string{"1.4.1", "1.5.0"},
+		},
+		{
+			constraint: "~> 1.4",
+			match:      []string{"1.4.0", "1.9.0"},
+			noMatch:    []string{"1.3.0", "2.0.0"},
+		},
+		{
+			constraint: "~>1.4.2",
+			match:      []string{"1.4.2", "1.4.8"},
+			noMatch:    []string{"1.5.0"},
+		},
+		{
+			constraint: "^3",
+			match:      []string{"3.0.0", "3.9.9"},
+			noMatch:    []string{"2.9.9", "4.0.0", "4.0.0-rc.1"},
+		},
+		{
+			constraint: "^0.2.3",
+			match:      []string{"0.2.3", "0.2.9"},
+			noMatch:    []string{"0.2.2", "0.3.0"},
+		},
+		{
+			constraint: "^0.0.3",
+			match:      []string{"0.0.3"},
+			noMatch:    []string{"0.0.4"},
+		},
+		{
+			constraint: "1.18",
+			match:      []string{"1.18.0", "go1.18.10"},
+			noMatch:    []string{"1.19.0", "1.17.13"},
+		},
+		{
+			constraint: "1.18.x",
+			match:      []string{"1.18.0", "1.18.10"},
+			noMatch:    []string{"1.19.0"},
+		},
+		{
+			constraint: "2.*",
+			match:      []string{"2.0.0", "2.99.0"},
+			noMatch:    []string{"3.0.0", "1.0.0"},
+		},
+		{
+			constraint: "*",
+			match:      []string{"0.0.1", "100.0.0"},
+			noMatch:    []string{"1.0.0-rc.1"},
+		},
+		{
+			constraint: "1.2.3",
+			match:      []string{"1.2.3", "v1.2.3"},
+			noMatch:    []string{"1.2.4"},
+		},
+		{
+			constraint: "!=1.2.3 >=1.2",
+			match:      []string{"1.2.4"},
+			noMatch:    []string{"1.2.3"},
+		},
+		{
+			constraint: ">1.2",
+			match:      []string{"1.3.0", "1.4.0"},
+			noMatch:    []string{"1.2.9", "1.3.0-rc.1", "1.3.0-0", "1.4.0-beta"},
+		},
+		{
+			constraint: ">1",
+			match:      []string{"2.0.0", "3.1"},
+			noMatch:    []string{"1.9.9", "2.0.0-rc.1", "2.0.0-0"},
+		},
+		{
+			constraint: "<=1.2",
+			match:      []string{"1.2.9"},
+			noMatch:    []string{"1.3.0"},
+		},
+		{
+			constraint: "1.2 - 1.4",
+			match:      []string{"1.2.0", "1.4.9"},
+			noMatch:    []string{"1.1.9", "1.5.0"},
+		},
+		{
+			constraint: "1.2.3 - 2.3.4",
+			match:      []string{"1.2.3", "2.3.4"},
+			noMatch:    []string{"2.3.5"},
+		},
+		{
+			constraint: "^1 || ^3",
+			match:      []string{"1.5.0", "3.1.0"},
+			noMatch:    []string{"2.0.0"},
+		},
+		{
+			constraint: ">=1.2.0-rc.1 <2",
+			match:      []string{"1.2.0-rc.1", "1.2.0-rc.2", "1.2.0", "1.9"},
+			noMatch:    []string{"1.2.0-beta", "1.3.0-rc.1"},
+		},
+		{
+			constraint: ">=2023.1 <2024",
+			match:      []string{"2023.10.01"},
+			noMatch:    []string{"2024.01.01"},
+		},
+	} {
+		t.Run(tc.constraint, func(t *testing.T) {
+			c, err := version.ParseConstraint(tc.constraint)
+			if err != nil {
+				t.Fatal(err)
+			}
+			testutil.AssertEqual(t, "string", c.String(), tc.constraint)
+			for _, v := range tc.match {
+				if !c.Check(version.MustParse(v)) {
+					t.Errorf("%s does not match %s", v, tc.constraint)
+				}
+			}
+			for _, v := range tc.noMatch {
+				if c.Check(version.MustParse(v)) {
+					t.Errorf("%s matches %s", v, tc.constraint)
+				}
+			}
+		})
+	}
+}
+
+func TestConstraint_Contains(t *testing.T) {
+	c := version.MustParseConstraint("^1.2")
+	for v, want := range map[string]bool{
+		"1.5.0-beta": true,
+		"1.2.0-rc.1": false,
+		"2.0.0-rc.1": false,
+		"1.9.9":      true,
+	} {
+		if got := c.Contains(version.MustParse(v)); got != want {
+			t.Errorf("%s: got %v, want %v", v, got, want)
+		}
+	}
+}
+
+func TestParseConstraint_invalid(t *testing.T) {
+	for _, s := range []string{">=latest", "~1.2/3", "1.2 - stable", ">=1.2 <"} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := version.ParseConstraint(s); !errors.Is(err, version.ErrInvalidConstraint) {
+				t.Fatalf("got error %v, want %v", err, version.ErrInvalidConstraint)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package version parses and compares release versions. Besides semantic
+// versions, it handles loose semantic versions with a leading v or missing
+// minor and patch numbers, versions with four or more numeric segments and
+// calendar versions, and it matches versions against constraints like
+// ">=1.2 <2", "~1.4" or "^3".
+package version // import "newreleases.io/newreleases/version"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalid is returned when a version can not be parsed.
+var ErrInvalid = errors.New("invalid version")
+
+// Version is a parsed release version.
+type Version struct {
+	// Segments holds numeric segments of the version, like major, minor and
+	// patch numbers for semantic versions. It has at least one element.
+	Segments []int
+	// Prerelease holds the prerelease part of the version, like rc.1 in
+	// 1.0.0-rc.1 or b2 in 2.0b2.
+	Prerelease string
+	// Suffix holds a qualifier that does not mark a prerelease, like jre in
+	// 32.1.2-jre, Final in 5.6.15.Final or w in 1.1.1w.
+	Suffix string
+	// Metadata holds the build metadata after the + sign.
+	Metadata string
+
+	original string
+}
+
+// Parse parses a version string. An alphabetic prefix, like v in v1.2.3, go
+// in go1.21.0 or release- in release-1.2, is ignored.
+func Parse(s string) (v Version, err error) {
+	v.original = s
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, "+"); i >= 0 {
+		s, v.Metadata = s[:i], s[i+1:]
+	}
+
+	i := strings.IndexAny(s, "0123456789")
+	if i < 0 {
+		return Version{}, fmt.Errorf("%w %q", ErrInvalid, v.original)
+	}
+	for _, c := range s[:i] {
+		if !isLetter(c) && c != '-' && c != '_' && c != '.' {
+			return Version{}, fmt.Errorf("%w %q", ErrInvalid, v.original)
+		}
+	}
+	s = s[i:]
+
+	for {
+		j := 0
+		for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			j++
+		}
+		n, err := strconv.Atoi(s[:j])
+		if err != nil {
+			return Version{}, fmt.Errorf("%w %q: %v", ErrInvalid, v.original, err)
+		}
+		v.Segments = append(v.Segments, n)
+		s = s[j:]
+		if len(s) < 2 || s[0] != '.' || s[1] < '0' || s[1] > '9' {
+			break
+		}
+		s = s[1:]
+	}
+
+	for _, c := range s {
+		if !isLetter(c) && (c < '0' || c > '9') && c != '-' && c != '_' && c != '.' && c != '~' {
+			return Version{}, fmt.Errorf("%w %q", ErrInvalid, v.original)
+		}
+	}
+	if rest := strings.TrimLeft(s, "-._"); rest != "" {
+		if isPrerelease(rest, s[0] == '-') {
+			v.Prerelease = rest
+		} else {
+			v.Suffix = rest
+		}
+	}
+	return v, nil
+}
+
+// MustParse parses a version string and panics if it is not valid.
+func MustParse(s string) (v Version) {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Major returns the first numeric segment.
+func (v Version) Major() int { return v.Segment(0) }
+
+// Minor returns the second numeric segment, or zero if it is missing.
+func (v Version) Minor() int { return v.Segment(1) }
+
+// Patch returns the third numeric segment, or zero if it is missing.
+func (v Version) Patch() int { return v.Segment(2) }
+
+// Segment returns the numeric segment at the index, or zero if it is missing.
+func (v Version) Segment(i int) int {
+	if i < len(v.Segments) {
+		return v.Segments[i]
+	}
+	return 0
+}
+
+// IsPrerelease reports whether the version has a prerelease part.
+func (v Version) IsPrerelease() bool {
+	return v.Prerelease != ""
+}
+
+// Original returns the string that the version was parsed from.
+func (v Version) Original() string {
+	return v.original
+}
+
+// String returns the original version string, or a normalized one if the
+// version was not parsed.
+func (v Version) String() string {
+	if v.original != "" {
+		return v.original
+	}
+	s := make([]string, len(v.Segments))
+	for i, n := range v.Segments {
+		s[i] = strconv.Itoa(n)
+	}
+	r := strings.Join(s, ".")
+	if v.Prerelease != "" {
+		r += "-" + v.Prerelease
+	}
+	if v.Suffix != "" {
+		r += "-" + v.Suffix
+	}
+	if v.Metadata != "" {
+		r += "+" + v.Metadata
+	}
+	return r
+}
+
+// Compare returns -1, 0 or 1 if the version is lower than, equal to or greater
+// than the other version. Missing numeric segments are treated as zeros, so
+// 1.2 is equal to 1.2.0, and a prerelease is lower than the release with the
+// same numeric segments. Build metadata is ignored.
+func (v Version) Compare(o Version) int {
+	n := len(v.Segments)
+	if len(o.Segments) > n {
+		n = len(o.Segments)
+	}
+	for i := 0; i < n; i++ {
+		if c := compareInts(v.Segment(i), o.Segment(i)); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case v.Prerelease == "" && o.Prerelease != "":
+		return 1
+	case v.Prerelease != "" && o.Prerelease == "":
+		return -1
+	}
+	if c := comparePrerelease(v.Prerelease, o.Prerelease); c != 0 {
+		return c
+	}
+	return strings.Compare(v.Suffix, o.Suffix)
+}
+
+// LessThan reports whether the version is lower than the other version.
+func (v Version) LessThan(o Version) bool { return v.Compare(o) < 0 }
+
+// GreaterThan reports whether the version is greater than the other version.
+func (v Version) GreaterThan(o Version) bool { return v.Compare(o) > 0 }
+
+// Equal reports whether the versions are equal by their ordering.
+func (v Version) Equal(o Version) bool { return v.Compare(o) == 0 }
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// prereleaseStages ranks well known prerelease words, as their alphabetical
+// order does not reflect the release process.
+var prereleaseStages = map[string]int{
+	"dev":       0,
+	"snapshot":  0,
+	"nightly":   0,
+	"canary":    0,
+	"a":         1,
+	"alpha":     1,
+	"b":         2,
+	"beta":      2,
+	"m":         3,
+	"milestone": 3,
+	"ea":        3,
+	"pre":       4,
+	"preview":   4,
+	"next":      4,
+	"c":         5,
+	"cr":        5,
+	"rc":        5,
+}
+
+// releaseQualifiers are words that mark a release, or a variant of it, when
+// they follow the numeric segments after a hyphen, as in 5.3.0-Final or
+// 32.1.2-jre.
+var releaseQualifiers = map[string]struct{}{
+	"final":   {},
+	"ga":      {},
+	"release": {},
+	"stable":  {},
+	"sp":      {},
+	"jre":     {},
+	"android": {},
+}
+
+// isPrerelease reports whether the version suffix marks a prerelease. As in
+// semantic versioning, a suffix after a hyphen is a prerelease, like 1 in
+// 1.0.0-1 or x.7.z.92 in 1.0.0-x.7.z.92, unless it starts with a release
+// qualifier. Other suffixes are prereleases only if they contain a prerelease
+// stage word, like b2 in 2.0b2, so that 1.1.1w is not a prerelease.
+func isPrerelease(s string, hyphen bool) bool {
+	ids := prereleaseIdentifiers(s)
+	for _, id := range ids {
+		if _, ok := prereleaseStages[strings.ToLower(id)]; ok {
+			return true
+		}
+	}
+	if !hyphen {
+		return false
+	}
+	_, ok := releaseQualifiers[strings.ToLower(ids[0])]
+	return !ok
+}
+
+// prereleaseIdentifiers splits a prerelease into identifiers on separators
+// and boundaries between letters and digits.
+func prereleaseIdentifiers(s string) (ids []string) {
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i == len(s) || s[i] == '.' || s[i] == '-' || s[i] == '_' {
+			if i > start {
+				ids = append(ids, s[start:i])
+			}
+			start = i + 1
+			continue
+		}
+		if i > start && isDigit(s[i]) != isDigit(s[i-1]) {
+			ids = append(ids, s[start:i])
+			start = i
+		}
+	}
+	return ids
+}
+
+func comparePrerelease(a, b string) int {
+	x, y := prereleaseIdentifiers(a), prereleaseIdentifiers(b)
+	for i := 0; i < len(x) && i < len(y); i++ {
+		if c := compareIdentifiers(x[i], y[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInts(len(x), len(y))
+}
+
+func compareIdentifiers(a, b string) int {
+	an, aerr := strconv.Atoi(a)
+	bn, berr := strconv.Atoi(b)
+	switch {
+	case aerr == nil && berr == nil:
+		return compareInts(an, bn)
+	case aerr == nil:
+		return -1
+	case berr == nil:
+		return 1
+	}
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	as, aok := prereleaseStages[a]
+	bs, bok := prereleaseStages[b]
+	if aok && bok && as != bs {
+		return compareInts(as, bs)
+	}
+	return strings.Compare(a, b)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026, NewReleases Go client AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package version_test
+
+import (
+	"errors"
+	"testing"
+
+	"newreleases.io/newreleases/internal/testutil"
+	"newreleases.io/newreleases/version"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want version.Version
+	}{
+		{in: "1.2.3", want: version.Version{Segments: []int{1, 2, 3}}},
+		{in: "v1.2.3", want: version.Version{Segments: []int{1, 2, 3}}},
+		{in: "V2.0", want: version.Version{Segments: []int{2, 0}}},
+		{in: "3", want: version.Version{Segments: []int{3}}},
+		{in: "go1.21.0", want: version.Version{Segments: []int{1, 21, 0}}},
+		{in: "release-1.2", want: version.Version{Segments: []int{1, 2}}},
+		{in: "1.2.3-rc.1", want: version.Version{Segments: []int{1, 2, 3}, Prerelease: "rc.1"}},
+		{in: "1.2.3-beta.2+build.5", want: version.Version{Segments: []int{1, 2, 3}, Prerelease: "beta.2", Metadata: "build.5"}},
+		{in: "2.0b2", want: version.Version{Segments: []int{2, 0}, Prerelease: "b2"}},
+		{in: "6.0.0-SNAPSHOT", want: version.Version{Segments: []int{6, 0, 0}, Prerelease: "SNAPSHOT"}},
+		{in: "1.2.3.4", want: version.Version{Segments: []int{1, 2, 3, 4}}},
+		{in: "2023.09.01", want: version.Version{Segments: []int{2023, 9, 1}}},
+		{in: "23.04", want: version.Version{Segments: []int{23, 4}}},
+		{in: "32.1.2-jre", want: version.Version{Segments: []int{32, 1, 2}, Suffix: "jre"}},
+		{in: "5.6.15.Final", want: version.Version{Segments: []int{5, 6, 15}, Suffix: "Final"}},
+		{in: "1.1.1w", want: version.Version{Segments: []int{1, 1, 1}, Suffix: "w"}},
+		{in: "5.3.0-Final", want: version.Version{Segments: []int{5, 3, 0}, Suffix: "Final"}},
+		{in: "2.7.0-RELEASE", want: version.Version{Segments: []int{2, 7, 0}, Suffix: "RELEASE"}},
+		{in: "1.0.0-1", want: version.Version{Segments: []int{1, 0, 0}, Prerelease: "1"}},
+		{in: "1.0.0-0.3.7", want: version.Version{Segments: []int{1, 0, 0}, Prerelease: "0.3.7"}},
+		{in: "1.0.0-x.7.z.92", want: version.Version{Segments: []int{1, 0, 0}, Prerelease: "x.7.z.92"}},
+		{in: "v0.0.0-20191109021931-daa7c04131f5", want: version.Version{Segments: []int{0, 0, 0}, Prerelease: "20191109021931-daa7c04131f5"}},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := version.Parse(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			testutil.AssertEqual(t, "segments", got.Segments, tc.want.Segments)
+			testutil.AssertEqual(t, "prerelease", got.Prerelease, tc.want.Prerelease)
+			testutil.AssertEqual(t, "suffix", got.Suffix, tc.want.Suffix)
+			testutil.AssertEqual(t, "metadata", got.Metadata, tc.want.Metadata)
+			testutil.AssertEqual(t, "string", got.String(), tc.in)
+		})
+	}
+}
+
+func TestParse_invalid(t *testing.T) {
+	for _, in := range []string{"", "latest", "stable", "1/2", "99999999999999999999"} {
+		t.Run(in, func(t *testing.T) {
+			if _, err := version.Parse(in); !errors.Is(err, version.ErrInvalid) {
+				t.Fatalf("got error %v, want %v", err, version.ErrInvalid)
+			}
+		})
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	ordered := []string{
+		"0.9",
+		"1.0.0-dev",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"v1.2",
+		"1.2.0.1",
+		"1.10.0",
+		"2.0b1",
+		"2.0rc1",
+		"2.0",
+		"2023.01.15",
+		"2023.10.01",
+	}
+	for i := range ordered {
+		for j := range ordered {
+			a, b := version.MustParse(ordered[i]), version.MustParse(ordered[j])
+			want := 0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = 1
+			}
+			if got := a.Compare(b); got != want {
+				t.Errorf("compare %s with %s: got %v, want %v", a, b, got, want)
+			}
+		}
+	}
+
+	// Precedence examples from the semantic versioning specification.
+	semver := []string{
+		"1.0.0-0.3.7",
+		"1.0.0-1",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0-x.7.z.92",
+		"1.0.0",
+		"2.0.0",
+		"2.1.0",
+		"2.1.1",
+	}
+	for i := 0; i+1 < len(semver); i++ {
+		a, b := version.MustParse(semver[i]), version.MustParse(semver[i+1])
+		if !a.LessThan(b) {
+			t.Errorf("%s is not lower than %s", a, b)
+		}
+	}
+
+	equal := [][2]string{
+		{"1.2", "1.2.0"},
+		{"v1.2.3", "1.2.3"},
+		{"1.2.3+build.1", "1.2.3+build.2"},
+	}
+	for _, e := range equal {
+		if !version.MustParse(e[0]).Equal(version.MustParse(e[1])) {
+			t.Errorf("%s is not equal to %s", e[0], e[1])
+		}
+	}
+}
+
+func TestVersion_accessors(t *testing.T) {
+	v := version.MustParse("v4.5")
+	testutil.AssertEqual(t, "major", v.Major(), 4)
+	testutil.AssertEqual(t, "minor", v.Minor(), 5)
+	testutil.AssertEqual(t, "patch", v.Patch(), 0)
+	testutil.AssertEqual(t, "prerelease", v.IsPrerelease(), false)
+	testutil.AssertEqual(t, "original", v.Original(), "v4.5")
+	testutil.AssertEqual(t, "string", version.Version{Segments: []int{1, 2}, Prerelease: "rc.1"}.String(), "1.2-rc.1")
+}